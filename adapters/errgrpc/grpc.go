@@ -0,0 +1,257 @@
+// Package errgrpc provides the google.golang.org/grpc integration for the
+// github.com/neuronlabs/errors package.
+//
+// The server interceptors convert the classified errors returned by the handlers into the gRPC statuses
+// with the code mapped from the error class and the error class, id, details, operation and fields
+// carried in the status details. By default the statuses carry the public message of the errors
+// with the internal information redacted. The client interceptors restore the classified errors from these statuses.
+package errgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neuronlabs/errors"
+)
+
+// Domain is the domain of the status details errdetails.ErrorInfo carrying the classified errors.
+const Domain = "github.com/neuronlabs/errors"
+
+// The keys of the errdetails.ErrorInfo metadata.
+const (
+	MetadataClass     = "class"
+	MetadataID        = "id"
+	MetadataTimestamp = "timestamp"
+	MetadataDetails   = "details"
+	MetadataOperation = "operation"
+	MetadataFields    = "fields"
+)
+
+// DefaultCode is the gRPC code of the classified errors which class has no code defined.
+var DefaultCode = codes.Unknown
+
+var classCodes = struct {
+	sync.RWMutex
+	codes map[errors.Class]codes.Code
+}{codes: map[errors.Class]codes.Code{}}
+
+// SetClassCode sets the gRPC 'code' for the errors of the 'c' Class.
+// The code is also used by the classes with the same minor or major
+// unless they have their own code defined.
+// The 'c' might be the major, minor or index class.
+func SetClassCode(c errors.Class, code codes.Code) {
+	classCodes.Lock()
+	defer classCodes.Unlock()
+
+	classCodes.codes[c] = code
+}
+
+// ClassCode gets the gRPC code defined for the 'c' Class, its minor or major.
+// If none of them has the code defined the DefaultCode is returned.
+func ClassCode(c errors.Class) codes.Code {
+	classCodes.RLock()
+	defer classCodes.RUnlock()
+
+	for _, class := range c.Hierarchy() {
+		if code, ok := classCodes.codes[class]; ok {
+			return code
+		}
+	}
+	return DefaultCode
+}
+
+// Option is the option of the errors conversion into the gRPC statuses.
+type Option func(o *options)
+
+type options struct {
+	internal bool
+	policy   errors.RedactionPolicy
+}
+
+// WithRedactionPolicy sets the 'policy' used to strip the status details of the classified errors.
+// By default the errors.DefaultRedactionPolicy is used.
+func WithRedactionPolicy(policy errors.RedactionPolicy) Option {
+	return func(o *options) {
+		o.policy = policy
+	}
+}
+
+// WithInternalRendering sets the classified errors to be converted with their internal message
+// and all their information, the same as with the errors.RenderInternal function, and any other
+// errors to be converted with their message. It should be used only if the clients are trusted
+// i.e. for the internal services.
+func WithInternalRendering() Option {
+	return func(o *options) {
+		o.internal = true
+	}
+}
+
+// ToStatus converts the 'err' into the gRPC status. If the 'err' is a classified error or it wraps one,
+// the status code is mapped from its class and the status details contains the errdetails.ErrorInfo
+// with the 'CLASS_{class}' reason and the error class, id, timestamp, details, operation and fields metadata.
+// By default the status message is the public message of the error and its details are stripped
+// according to the errors.DefaultRedactionPolicy, the same as with the errors.RenderPublic function.
+// The gRPC status errors are converted with the status.Convert function and any other error
+// is converted into the codes.Unknown status with the errors.DefaultPublicMessage.
+// The internal information might be sent with the WithInternalRendering option.
+func ToStatus(err error, opts ...Option) *status.Status {
+	o := &options{policy: errors.DefaultRedactionPolicy}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if _, ok := errors.AsClassError(err); !ok {
+		if _, ok := status.FromError(err); !ok && !o.internal {
+			return status.New(codes.Unknown, errors.DefaultPublicMessage)
+		}
+		return status.Convert(err)
+	}
+
+	view := errors.RenderPublic(err, o.policy)
+	if o.internal {
+		view = errors.RenderInternal(err)
+	}
+	info := &errdetails.ErrorInfo{
+		Reason:   "CLASS_" + strconv.FormatUint(uint64(view.Class), 10),
+		Domain:   Domain,
+		Metadata: map[string]string{MetadataClass: strconv.FormatUint(uint64(view.Class), 10)},
+	}
+	setMetadata(info.Metadata, MetadataID, view.ID)
+	setMetadata(info.Metadata, MetadataTimestamp, view.Timestamp)
+	setMetadata(info.Metadata, MetadataDetails, view.Details)
+	setMetadata(info.Metadata, MetadataOperation, view.Operation)
+	if len(view.Fields) > 0 {
+		if fields, err := json.Marshal(view.Fields); err == nil {
+			info.Metadata[MetadataFields] = string(fields)
+		}
+	}
+
+	st := status.New(ClassCode(view.Class), view.Message)
+	if withDetails, err := st.WithDetails(info); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// FromStatus restores the classified error from the 'st' status created by the ToStatus function.
+// If the status doesn't carry the classified error its error is returned.
+func FromStatus(st *status.Status) error {
+	if err, ok := restore(st); ok {
+		return err
+	}
+	return st.Err()
+}
+
+// FromError restores the classified error from the 'err' gRPC status error.
+// If the 'err' is not a gRPC status error carrying the classified error it is returned unchanged.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if restored, ok := restore(st); ok {
+		return restored
+	}
+	return err
+}
+
+// restore restores the classified error from the errdetails.ErrorInfo of the 'st' status.
+func restore(st *status.Status) (errors.ClassError, bool) {
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != Domain {
+			continue
+		}
+		class, err := strconv.ParseUint(info.Metadata[MetadataClass], 10, 32)
+		if err != nil {
+			continue
+		}
+		view := errors.View{
+			Class:     errors.Class(class),
+			ID:        info.Metadata[MetadataID],
+			Timestamp: info.Metadata[MetadataTimestamp],
+			Message:   st.Message(),
+			Details:   info.Metadata[MetadataDetails],
+			Operation: info.Metadata[MetadataOperation],
+		}
+		if fields := info.Metadata[MetadataFields]; fields != "" {
+			json.Unmarshal([]byte(fields), &view.Fields)
+		}
+		return errors.Restore(view), true
+	}
+	return nil, false
+}
+
+// UnaryServerInterceptor gets the grpc.UnaryServerInterceptor that converts the handler errors
+// with the ToStatus function and provided 'opts'.
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, ToStatus(err, opts...).Err()
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor gets the grpc.StreamServerInterceptor that converts the handler errors
+// with the ToStatus function and provided 'opts'.
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return ToStatus(err, opts...).Err()
+		}
+		return nil
+	}
+}
+
+// UnaryClientInterceptor gets the grpc.UnaryClientInterceptor that restores the classified errors
+// with the FromError function.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientInterceptor gets the grpc.StreamClientInterceptor that restores the classified errors
+// of the stream with the FromError function.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, FromError(err)
+		}
+		return &clientStream{ClientStream: cs}, nil
+	}
+}
+
+// clientStream is the grpc.ClientStream that restores the classified errors.
+type clientStream struct {
+	grpc.ClientStream
+}
+
+// SendMsg implements grpc.ClientStream interface.
+func (c *clientStream) SendMsg(m interface{}) error {
+	return FromError(c.ClientStream.SendMsg(m))
+}
+
+// RecvMsg implements grpc.ClientStream interface.
+func (c *clientStream) RecvMsg(m interface{}) error {
+	return FromError(c.ClientStream.RecvMsg(m))
+}
+
+func setMetadata(metadata map[string]string, key, value string) {
+	if value != "" {
+		metadata[key] = value
+	}
+}
@@ -0,0 +1,215 @@
+package errgrpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/neuronlabs/errors"
+)
+
+// testServer is the test service returning the errors defined by the request value.
+type testServer struct {
+	errs map[string]error
+}
+
+func (s *testServer) unary(_ context.Context, req *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	if err, ok := s.errs[req.Value]; ok {
+		return nil, err
+	}
+	return wrapperspb.String(req.Value), nil
+}
+
+func (s *testServer) stream(req *wrapperspb.StringValue, stream grpc.ServerStream) error {
+	if err := stream.SendMsg(wrapperspb.String(req.Value)); err != nil {
+		return err
+	}
+	if err, ok := s.errs[req.Value]; ok {
+		return err
+	}
+	return nil
+}
+
+var testServiceDesc = grpc.ServiceDesc{
+	ServiceName: "errgrpc.Test",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Unary",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := &wrapperspb.StringValue{}
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/errgrpc.Test/Unary"}
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.(*testServer).unary(ctx, req.(*wrapperspb.StringValue))
+			})
+		},
+	}},
+	Streams: []grpc.StreamDesc{{
+		StreamName:    "Stream",
+		ServerStreams: true,
+		Handler: func(srv interface{}, stream grpc.ServerStream) error {
+			req := &wrapperspb.StringValue{}
+			if err := stream.RecvMsg(req); err != nil {
+				return err
+			}
+			return srv.(*testServer).stream(req, stream)
+		},
+	}},
+}
+
+// TestInterceptors tests the classified errors conversion between the gRPC server and client.
+func TestInterceptors(t *testing.T) {
+	mjr := errors.MustNewMajor()
+	mnr := errors.MustNewMinor(mjr)
+	clNotFound := errors.MustNewClassWIndex(mjr, mnr)
+	clInvalid := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+	SetClassCode(errors.MustNewMinorClass(mjr, mnr), codes.NotFound)
+	SetClassCode(clInvalid, codes.InvalidArgument)
+
+	detailed := errors.Build(clNotFound).Msg("user not found").Detail("user: 'john' not found").Field("model", "user").Err()
+
+	srv := &testServer{errs: map[string]error{
+		"detailed": detailed,
+		"wrapped":  fmt.Errorf("handler: %w", errors.New(clInvalid, "invalid filter")),
+		"status":   status.Error(codes.PermissionDenied, "denied"),
+		"plain":    io.ErrUnexpectedEOF,
+	}}
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor(WithInternalRendering())),
+		grpc.StreamInterceptor(StreamServerInterceptor(WithInternalRendering())),
+	)
+	server.RegisterService(&testServiceDesc, srv)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	unary := func(value string) error {
+		return conn.Invoke(context.Background(), "/errgrpc.Test/Unary", wrapperspb.String(value), &wrapperspb.StringValue{})
+	}
+
+	t.Run("Detailed", func(t *testing.T) {
+		err := unary("detailed")
+		require.Error(t, err)
+
+		restored, ok := err.(errors.DetailedError)
+		require.True(t, ok)
+		assert.Equal(t, clNotFound, restored.Class())
+		assert.Equal(t, detailed.ID(), restored.ID())
+		assert.True(t, detailed.(errors.Timestamper).Timestamp().Equal(restored.(errors.Timestamper).Timestamp()))
+		assert.Equal(t, "user not found", restored.Error())
+		assert.Equal(t, "user: 'john' not found", restored.Details())
+		assert.Equal(t, detailed.Operation(), restored.Operation())
+		assert.Equal(t, map[string]interface{}{"model": "user"}, restored.(errors.Fielder).Fields())
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		err := unary("wrapped")
+		require.Error(t, err)
+
+		restored, ok := err.(errors.ClassError)
+		require.True(t, ok)
+		assert.Equal(t, clInvalid, restored.Class())
+		assert.Equal(t, "handler: invalid filter", restored.Error())
+		_, ok = err.(errors.DetailedError)
+		assert.False(t, ok)
+	})
+
+	t.Run("Other", func(t *testing.T) {
+		err := unary("status")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = unary("plain")
+		assert.Equal(t, codes.Unknown, status.Code(err))
+
+		assert.NoError(t, unary("ok"))
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		stream, err := conn.NewStream(context.Background(), &testServiceDesc.Streams[0], "/errgrpc.Test/Stream")
+		require.NoError(t, err)
+		require.NoError(t, stream.SendMsg(wrapperspb.String("detailed")))
+		require.NoError(t, stream.CloseSend())
+
+		resp := &wrapperspb.StringValue{}
+		require.NoError(t, stream.RecvMsg(resp))
+		assert.Equal(t, "detailed", resp.Value)
+
+		err = stream.RecvMsg(resp)
+		restored, ok := err.(errors.DetailedError)
+		require.True(t, ok)
+		assert.Equal(t, detailed.ID(), restored.ID())
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		st := ToStatus(detailed, WithInternalRendering())
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Equal(t, "user not found", st.Message())
+
+		st = ToStatus(errors.New(clInvalid, "invalid"))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		other := errors.MustNewMajor()
+		DefaultCode = codes.Internal
+		defer func() { DefaultCode = codes.Unknown }()
+		assert.Equal(t, codes.Internal, ToStatus(errors.New(errors.MustNewMajorClass(other), "other")).Code())
+
+		err := FromStatus(status.New(codes.Aborted, "aborted"))
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Nil(t, FromError(nil))
+	})
+
+	t.Run("Public", func(t *testing.T) {
+		errors.SetClassPublicMessage(clNotFound, "model not found")
+
+		st := ToStatus(detailed)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Equal(t, "model not found", st.Message())
+
+		restored, ok := FromStatus(st).(errors.DetailedError)
+		require.True(t, ok)
+		assert.Equal(t, clNotFound, restored.Class())
+		assert.Equal(t, detailed.ID(), restored.ID())
+		assert.Empty(t, restored.Details())
+		assert.Empty(t, restored.Operation())
+		assert.Empty(t, restored.(errors.Fielder).Fields())
+
+		st = ToStatus(detailed, WithRedactionPolicy(errors.RedactionPolicy{RedactOperation: true}))
+		restored, ok = FromStatus(st).(errors.DetailedError)
+		require.True(t, ok)
+		assert.Equal(t, "model not found", restored.Error())
+		assert.Equal(t, "user: 'john' not found", restored.Details())
+		assert.Empty(t, restored.Operation())
+
+		st = ToStatus(io.ErrUnexpectedEOF)
+		assert.Equal(t, codes.Unknown, st.Code())
+		assert.Equal(t, errors.DefaultPublicMessage, st.Message())
+		assert.Equal(t, "unexpected EOF", ToStatus(io.ErrUnexpectedEOF, WithInternalRendering()).Message())
+
+		st = ToStatus(status.Error(codes.PermissionDenied, "denied"))
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		assert.Equal(t, "denied", st.Message())
+	})
+}
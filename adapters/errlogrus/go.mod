@@ -0,0 +1,19 @@
+module github.com/neuronlabs/errors/adapters/errlogrus
+
+go 1.21
+
+replace github.com/neuronlabs/errors => ../..
+
+require (
+	github.com/neuronlabs/errors v0.0.0
+	github.com/sirupsen/logrus v1.9.3
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
@@ -0,0 +1,43 @@
+// Package errlogrus provides the github.com/sirupsen/logrus logger integration for the
+// github.com/neuronlabs/errors package.
+//
+// The errors are marshaled into the logrus fields described by the errors.LogFields function.
+// The nested groups are flattened with their keys joined with a dot i.e. 'error.fields.model'.
+package errlogrus
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/neuronlabs/errors"
+)
+
+// Fields gets the logrus fields of provided 'err' with the keys prefixed with the 'error' key.
+func Fields(err error) logrus.Fields {
+	return NamedFields(logrus.ErrorKey, err)
+}
+
+// NamedFields gets the logrus fields of provided 'err' with the keys prefixed with the 'key'.
+// If the 'err' is nil, empty fields are returned.
+func NamedFields(key string, err error) logrus.Fields {
+	f := logrus.Fields{}
+	if err != nil {
+		flatten(f, key, errors.LogFields(err))
+	}
+	return f
+}
+
+// WithError adds the 'err' fields to the 'entry'.
+func WithError(entry *logrus.Entry, err error) *logrus.Entry {
+	return entry.WithFields(Fields(err))
+}
+
+func flatten(f logrus.Fields, prefix string, fields []errors.LogField) {
+	for _, field := range fields {
+		key := prefix + "." + field.Key
+		if group, ok := field.Value.([]errors.LogField); ok {
+			flatten(f, key, group)
+			continue
+		}
+		f[key] = field.Value
+	}
+}
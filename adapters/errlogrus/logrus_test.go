@@ -0,0 +1,43 @@
+package errlogrus
+
+import (
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestFields tests the logrus errors marshaling.
+func TestFields(t *testing.T) {
+	mjr := errors.MustNewMajor()
+	class := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+
+	err := errors.Build(class).Msg("detailed").Field("model", "user").Cause(io.EOF).Err()
+
+	assert.Equal(t, logrus.Fields{
+		"error.message":      "detailed",
+		"error.class":        uint64(class),
+		"error.severity":     "error",
+		"error.id":           err.ID().String(),
+		"error.timestamp":    err.(errors.Timestamper).Timestamp(),
+		"error.operation":    err.Operation(),
+		"error.fields.model": "user",
+		"error.cause":        "EOF",
+	}, Fields(err))
+
+	assert.Equal(t, logrus.Fields{
+		"errs.count":      2,
+		"errs.0":          "EOF",
+		"errs.1.message":  "simple",
+		"errs.1.class":    uint64(class),
+		"errs.1.severity": "error",
+	}, NamedFields("errs", errors.MultiError{io.EOF, errors.New(class, "simple")}))
+
+	assert.Empty(t, Fields(nil))
+
+	entry := WithError(logrus.NewEntry(logrus.New()), err)
+	assert.Equal(t, "detailed", entry.Data["error.message"])
+}
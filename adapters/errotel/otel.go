@@ -0,0 +1,151 @@
+// Package errotel provides the OpenTelemetry tracing integration for the
+// github.com/neuronlabs/errors package.
+//
+// The classified errors are recorded on the spans as the exception events
+// and the spans are tagged with the error class attributes.
+package errotel
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/neuronlabs/errors"
+)
+
+// The attribute keys of the recorded errors.
+const (
+	ClassKey      = attribute.Key("error.class")
+	ClassNameKey  = attribute.Key("error.class.name")
+	ClassOwnerKey = attribute.Key("error.class.owner")
+	MajorKey      = attribute.Key("error.class.major")
+	MinorKey      = attribute.Key("error.class.minor")
+	IndexKey      = attribute.Key("error.class.index")
+	SeverityKey   = attribute.Key("error.severity")
+	IDKey         = attribute.Key("error.id")
+	DetailsKey    = attribute.Key("error.details")
+	OperationKey  = attribute.Key("error.operation")
+)
+
+// RecordError records the 'err' on the 'span'. The span status is set to error with the 'err' message
+// and an exception event is added with the error class, id, details and the stack of its operations.
+// The span is tagged with the class attributes of the error.
+// Each error of a MultiError is recorded as a separate exception event and the span is tagged
+// with the class attributes of its most serious classified error.
+// The 'options' are passed to each exception event.
+func RecordError(span trace.Span, err error, options ...trace.EventOption) {
+	if err == nil || !span.IsRecording() {
+		return
+	}
+
+	var tagged errors.ClassError
+	if multi, ok := err.(errors.MultiError); ok {
+		for _, e := range multi {
+			classError, ok := addExceptionEvent(span, e, options)
+			if ok && (tagged == nil || errors.SeverityOf(classError) > errors.SeverityOf(tagged)) {
+				tagged = classError
+			}
+		}
+	} else {
+		tagged, _ = addExceptionEvent(span, err, options)
+	}
+
+	if tagged != nil {
+		span.SetAttributes(ClassAttributes(tagged.Class())...)
+		span.SetAttributes(SeverityKey.String(errors.SeverityOf(tagged).String()))
+	}
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// ClassAttributes gets the attributes describing the 'c' Class: its value, major, minor, index,
+// name and owner. The name and owner are included only if they are defined for the class.
+func ClassAttributes(c errors.Class) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		ClassKey.Int64(int64(c)),
+		MajorKey.Int(int(c.Major())),
+		MinorKey.Int(int(c.Minor())),
+		IndexKey.Int(int(c.Index())),
+	}
+	if name := errors.ClassName(c); name != "" {
+		attrs = append(attrs, ClassNameKey.String(name))
+	}
+	if owner := errors.ClassOwner(c); owner != "" {
+		attrs = append(attrs, ClassOwnerKey.String(owner))
+	}
+	return attrs
+}
+
+// ErrorAttributes gets the exception event attributes of the 'err'.
+// The exception type of the classified error is its class name or the dot separated
+// major, minor and index if the class has no name. The stacktrace of the detailed error
+// is composed of its operations followed by the operations of its details.
+func ErrorAttributes(err error) []attribute.KeyValue {
+	classError, ok := errors.AsClassError(err)
+	if !ok {
+		return []attribute.KeyValue{
+			semconv.ExceptionType(fmt.Sprintf("%T", err)),
+			semconv.ExceptionMessage(err.Error()),
+		}
+	}
+
+	class := classError.Class()
+	exceptionType := errors.ClassName(class)
+	if exceptionType == "" {
+		exceptionType = class.Key()
+	}
+	attrs := []attribute.KeyValue{
+		semconv.ExceptionType(exceptionType),
+		semconv.ExceptionMessage(err.Error()),
+		ClassKey.Int64(int64(class)),
+	}
+	if e, ok := classError.(errors.Indexer); ok {
+		attrs = append(attrs, IDKey.String(e.ID().String()))
+	}
+	if e, ok := classError.(errors.Detailer); ok {
+		if details := e.Details(); details != "" {
+			attrs = append(attrs, DetailsKey.String(details))
+		}
+	}
+	if e, ok := classError.(errors.Operationer); ok {
+		if operation := e.Operation(); operation != "" {
+			attrs = append(attrs, OperationKey.String(operation))
+		}
+	}
+	if stack := stacktrace(classError); stack != "" {
+		attrs = append(attrs, semconv.ExceptionStacktrace(stack))
+	}
+	return attrs
+}
+
+// addExceptionEvent adds the 'err' exception event to the 'span'.
+// Returns the classified error found in the 'err' chain.
+func addExceptionEvent(span trace.Span, err error, options []trace.EventOption) (errors.ClassError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	options = append([]trace.EventOption{trace.WithAttributes(ErrorAttributes(err)...)}, options...)
+	span.AddEvent(semconv.ExceptionEventName, options...)
+
+	return errors.AsClassError(err)
+}
+
+// stacktrace gets the stack of the error operations, each in a separate line.
+// The operation chain is followed by the detail entries operations.
+func stacktrace(err errors.ClassError) string {
+	var lines []string
+	if e, ok := err.(errors.Operationer); ok && e.Operation() != "" {
+		lines = append(lines, strings.Split(e.Operation(), "|")...)
+	}
+	if e, ok := err.(errors.DetailEntrier); ok {
+		for _, detail := range e.DetailEntries() {
+			if detail.Operation != "" {
+				lines = append(lines, detail.Operation+" ("+detail.Text+")")
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,126 @@
+package errotel
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestRecordError tests recording the errors on the spans.
+func TestRecordError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer provider.Shutdown(context.Background())
+	tracer := provider.Tracer("errotel")
+
+	mjr := errors.MustNewMajor()
+	mnr := errors.MustNewMinor(mjr)
+	class := errors.MustNewClassWIndex(mjr, mnr)
+	critical := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+	errors.SetClassName(class, "invalid filter")
+	errors.SetClassOwner(errors.MustNewMajorClass(mjr), "storage-team")
+	errors.SetClassSeverity(critical, errors.SeverityCritical)
+
+	t.Run("Detailed", func(t *testing.T) {
+		exporter.Reset()
+
+		err := errors.NewDet(class, "detailed")
+		err.SetDetails("unknown field")
+		err.AppendOperation("handler")
+
+		_, span := tracer.Start(context.Background(), "detailed")
+		RecordError(span, err)
+		span.End()
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		s := spans[0]
+		assert.Equal(t, codes.Error, s.Status.Code)
+		assert.Equal(t, "detailed", s.Status.Description)
+
+		attrs := attribute.NewSet(s.Attributes...)
+		assertAttr(t, attrs, ClassKey, attribute.Int64Value(int64(class)))
+		assertAttr(t, attrs, MajorKey, attribute.IntValue(int(mjr)))
+		assertAttr(t, attrs, MinorKey, attribute.IntValue(int(mnr)))
+		assertAttr(t, attrs, IndexKey, attribute.IntValue(int(class.Index())))
+		assertAttr(t, attrs, ClassNameKey, attribute.StringValue("invalid filter"))
+		assertAttr(t, attrs, ClassOwnerKey, attribute.StringValue("storage-team"))
+		assertAttr(t, attrs, SeverityKey, attribute.StringValue("error"))
+
+		require.Len(t, s.Events, 1)
+		event := s.Events[0]
+		assert.Equal(t, semconv.ExceptionEventName, event.Name)
+		attrs = attribute.NewSet(event.Attributes...)
+		assertAttr(t, attrs, semconv.ExceptionTypeKey, attribute.StringValue("invalid filter"))
+		assertAttr(t, attrs, semconv.ExceptionMessageKey, attribute.StringValue("detailed"))
+		assertAttr(t, attrs, IDKey, attribute.StringValue(err.ID().String()))
+		assertAttr(t, attrs, DetailsKey, attribute.StringValue("unknown field"))
+		assertAttr(t, attrs, OperationKey, attribute.StringValue(err.Operation()))
+
+		stack, ok := attrs.Value(semconv.ExceptionStacktraceKey)
+		require.True(t, ok)
+		assert.Regexp(t, `^.*TestRecordError.*#otel_test\.go:\d+\nhandler\n.*TestRecordError.*#otel_test\.go:\d+ \(unknown field\)$`, stack.AsString())
+	})
+
+	t.Run("Multi", func(t *testing.T) {
+		exporter.Reset()
+
+		err := errors.MultiError{io.EOF, errors.New(class, "simple"), errors.New(critical, "critical")}
+		_, span := tracer.Start(context.Background(), "multi")
+		RecordError(span, err)
+		span.End()
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		s := spans[0]
+		assert.Equal(t, codes.Error, s.Status.Code)
+		assert.Equal(t, err.Error(), s.Status.Description)
+
+		attrs := attribute.NewSet(s.Attributes...)
+		assertAttr(t, attrs, ClassKey, attribute.Int64Value(int64(critical)))
+		assertAttr(t, attrs, SeverityKey, attribute.StringValue("critical"))
+
+		require.Len(t, s.Events, 3)
+		attrs = attribute.NewSet(s.Events[0].Attributes...)
+		assertAttr(t, attrs, semconv.ExceptionTypeKey, attribute.StringValue("*errors.errorString"))
+		_, ok := attrs.Value(ClassKey)
+		assert.False(t, ok)
+
+		attrs = attribute.NewSet(s.Events[2].Attributes...)
+		assertAttr(t, attrs, semconv.ExceptionTypeKey, attribute.StringValue(critical.Key()))
+		_, ok = attrs.Value(IDKey)
+		assert.False(t, ok)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		exporter.Reset()
+
+		_, span := tracer.Start(context.Background(), "nil")
+		RecordError(span, nil)
+		span.End()
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+		assert.Empty(t, spans[0].Events)
+	})
+}
+
+func assertAttr(t *testing.T, attrs attribute.Set, key attribute.Key, expected attribute.Value) {
+	t.Helper()
+
+	value, ok := attrs.Value(key)
+	if assert.True(t, ok, "attribute: %s", key) {
+		assert.Equal(t, expected, value, "attribute: %s", key)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/neuronlabs/errors/adapters/errzap
+
+go 1.21
+
+replace github.com/neuronlabs/errors => ../..
+
+require (
+	github.com/neuronlabs/errors v0.0.0
+	github.com/stretchr/testify v1.8.1
+	go.uber.org/zap v1.27.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,76 @@
+// Package errzap provides the go.uber.org/zap logger integration for the
+// github.com/neuronlabs/errors package.
+//
+// The errors are marshaled into the zap objects described by the errors.LogFields function.
+package errzap
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/neuronlabs/errors"
+)
+
+// compile time check for the ObjectMarshaler interface.
+var _ zapcore.ObjectMarshaler = Object{}
+
+// Error creates the zap.Field with the 'error' key for provided 'err'.
+func Error(err error) zap.Field {
+	return NamedError("error", err)
+}
+
+// NamedError creates the zap.Field with provided 'key' for the 'err'.
+// If the 'err' is nil the field is skipped.
+func NamedError(key string, err error) zap.Field {
+	if err == nil {
+		return zap.Skip()
+	}
+	return zap.Object(key, Object{err: err})
+}
+
+// Object is the zapcore.ObjectMarshaler for the classified, detailed and multi errors.
+type Object struct {
+	err error
+}
+
+// NewObject creates new error Object marshaler for provided 'err'.
+func NewObject(err error) Object {
+	return Object{err: err}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler interface.
+func (o Object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if o.err == nil {
+		return nil
+	}
+	return fields(errors.LogFields(o.err)).MarshalLogObject(enc)
+}
+
+type fields []errors.LogField
+
+// MarshalLogObject implements zapcore.ObjectMarshaler interface.
+func (f fields) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, field := range f {
+		switch v := field.Value.(type) {
+		case []errors.LogField:
+			if err := enc.AddObject(field.Key, fields(v)); err != nil {
+				return err
+			}
+		case string:
+			enc.AddString(field.Key, v)
+		case uint64:
+			enc.AddUint64(field.Key, v)
+		case int:
+			enc.AddInt(field.Key, v)
+		case time.Time:
+			enc.AddTime(field.Key, v)
+		default:
+			if err := enc.AddReflected(field.Key, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package errzap
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestError tests the zap errors marshaling.
+func TestError(t *testing.T) {
+	mjr := errors.MustNewMajor()
+	class := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+
+	err := errors.Build(class).Msg("detailed").Field("model", "user").Cause(io.EOF).Err()
+
+	enc := zapcore.NewMapObjectEncoder()
+	Error(err).AddTo(enc)
+
+	obj, ok := enc.Fields["error"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "detailed", obj["message"])
+	assert.Equal(t, uint64(class), obj["class"])
+	assert.Equal(t, "error", obj["severity"])
+	assert.Equal(t, err.ID().String(), obj["id"])
+	assert.Equal(t, err.(errors.Timestamper).Timestamp(), obj["timestamp"])
+	assert.Equal(t, err.Operation(), obj["operation"])
+	assert.Equal(t, map[string]interface{}{"model": "user"}, obj["fields"])
+	assert.Equal(t, "EOF", obj["cause"])
+
+	enc = zapcore.NewMapObjectEncoder()
+	NamedError("errs", errors.MultiError{io.EOF, errors.New(class, "simple")}).AddTo(enc)
+	obj, ok = enc.Fields["errs"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 2, obj["count"])
+	assert.Equal(t, "EOF", obj["0"])
+	assert.Equal(t, "simple", obj["1"].(map[string]interface{})["message"])
+
+	assert.Equal(t, zap.Skip(), Error(nil))
+
+	enc = zapcore.NewMapObjectEncoder()
+	require.NoError(t, NewObject(nil).MarshalLogObject(enc))
+	assert.Empty(t, enc.Fields)
+}
@@ -0,0 +1,20 @@
+module github.com/neuronlabs/errors/adapters/errzerolog
+
+go 1.21
+
+replace github.com/neuronlabs/errors => ../..
+
+require (
+	github.com/neuronlabs/errors v0.0.0
+	github.com/rs/zerolog v1.33.0
+	github.com/stretchr/testify v1.3.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/google/uuid v1.1.1 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.19 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.12.0 // indirect
+)
@@ -0,0 +1,67 @@
+// Package errzerolog provides the github.com/rs/zerolog logger integration for the
+// github.com/neuronlabs/errors package.
+//
+// The errors are marshaled into the zerolog dictionaries described by the errors.LogFields function.
+package errzerolog
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/neuronlabs/errors"
+)
+
+// compile time check for the LogObjectMarshaler interface.
+var _ zerolog.LogObjectMarshaler = Object{}
+
+// Object is the zerolog.LogObjectMarshaler for the classified, detailed and multi errors.
+// Use it with the zerolog.Event Object method i.e.:
+//
+//	log.Error().Object("error", errzerolog.NewObject(err)).Msg("failed")
+type Object struct {
+	err error
+}
+
+// NewObject creates new error Object marshaler for provided 'err'.
+func NewObject(err error) Object {
+	return Object{err: err}
+}
+
+// MarshalZerologObject implements zerolog.LogObjectMarshaler interface.
+func (o Object) MarshalZerologObject(e *zerolog.Event) {
+	if o.err == nil {
+		return
+	}
+	fields(errors.LogFields(o.err)).MarshalZerologObject(e)
+}
+
+// Error adds the 'err' under the 'error' key of the 'e' event.
+func Error(e *zerolog.Event, err error) *zerolog.Event {
+	if err == nil {
+		return e
+	}
+	return e.Object(zerolog.ErrorFieldName, Object{err: err})
+}
+
+type fields []errors.LogField
+
+// MarshalZerologObject implements zerolog.LogObjectMarshaler interface.
+func (f fields) MarshalZerologObject(e *zerolog.Event) {
+	for _, field := range f {
+		switch v := field.Value.(type) {
+		case []errors.LogField:
+			e.Object(field.Key, fields(v))
+		case string:
+			e.Str(field.Key, v)
+		case uint64:
+			e.Uint64(field.Key, v)
+		case int:
+			e.Int(field.Key, v)
+		case time.Time:
+			e.Time(field.Key, v)
+		default:
+			e.Interface(field.Key, v)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package errzerolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestObject tests the zerolog errors marshaling.
+func TestObject(t *testing.T) {
+	mjr := errors.MustNewMajor()
+	class := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+
+	err := errors.Build(class).Msg("detailed").Field("model", "user").Cause(io.EOF).Err()
+
+	buf := &bytes.Buffer{}
+	logger := zerolog.New(buf)
+	Error(logger.Error(), err).Object("errs", NewObject(errors.MultiError{io.EOF})).Msg("failed")
+
+	record := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	obj, ok := record["error"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "detailed", obj["message"])
+	assert.Equal(t, float64(class), obj["class"])
+	assert.Equal(t, "error", obj["severity"])
+	assert.Equal(t, err.ID().String(), obj["id"])
+	assert.Equal(t, err.Operation(), obj["operation"])
+	assert.Equal(t, map[string]interface{}{"model": "user"}, obj["fields"])
+	assert.Equal(t, "EOF", obj["cause"])
+	assert.Equal(t, map[string]interface{}{"count": float64(1), "0": "EOF"}, record["errs"])
+
+	buf.Reset()
+	Error(logger.Info(), nil).Msg("ok")
+	assert.NotContains(t, buf.String(), "error")
+}
@@ -0,0 +1,118 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Builder is the fluent DetailedError builder.
+// It allows to create a detailed error within a single expression i.e.:
+//
+//	errors.Build(ClNotFound).Msgf("model: '%s' not found", name).Field("model", name).Cause(err).Err()
+//
+// Once the error is built with the Err method, the builder methods are no-ops,
+// so that the returned error is never modified by the builder.
+type Builder struct {
+	err   *detailedError
+	built bool
+}
+
+// Build creates new DetailedError Builder for the 'c' Class.
+// The runtime operation of the error is the function that called Build.
+func Build(c Class) *Builder {
+	return &Builder{err: newDetailed(c)}
+}
+
+// Msg sets the error message.
+func (b *Builder) Msg(message string) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.message = message
+	return b
+}
+
+// Msgf sets the error message formatted with provided 'format' and 'args'.
+func (b *Builder) Msgf(format string, args ...interface{}) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.message = fmt.Sprintf(format, args...)
+	return b
+}
+
+// Detail sets the error details.
+func (b *Builder) Detail(detail string) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.setDetail(Detail{Text: detail, Operation: callerOperation(1)})
+	return b
+}
+
+// Detailf sets the error details formatted with provided 'format' and 'args'.
+func (b *Builder) Detailf(format string, args ...interface{}) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.setDetail(Detail{Text: fmt.Sprintf(format, args...), Operation: callerOperation(1)})
+	return b
+}
+
+// Field sets the error field with the 'key' and 'value'.
+func (b *Builder) Field(key string, value interface{}) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.SetField(key, value)
+	return b
+}
+
+// Cause sets the error that caused the built error.
+// If the message is not set, the message of the 'err' is used.
+func (b *Builder) Cause(err error) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.cause = err
+	return b
+}
+
+// Op appends the 'operation' to the error operations chain.
+func (b *Builder) Op(operation string) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.AppendOperation(operation)
+	return b
+}
+
+// Public sets the error public message.
+func (b *Builder) Public(message string) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.SetPublicMessage(message)
+	return b
+}
+
+// Severity sets the error instance severity.
+func (b *Builder) Severity(s Severity) *Builder {
+	if b.built {
+		return b
+	}
+	b.err.SetSeverity(s)
+	return b
+}
+
+// Err gets the built DetailedError.
+func (b *Builder) Err() DetailedError {
+	if b.built {
+		return b.err
+	}
+	b.built = true
+	if b.err.message == "" && b.err.cause != nil {
+		b.err.message = b.err.cause.Error()
+	}
+	notifyCreated(b.err)
+	return b.err
+}
@@ -0,0 +1,58 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBuilder tests the fluent detailed error builder.
+func TestBuilder(t *testing.T) {
+	resetContainer()
+
+	err := Build(ClInvalidIndex).
+		Msgf("model: '%s' not found", "user").
+		Detailf("The model: '%s' is not registered.", "user").
+		Field("model", "user").
+		Cause(io.EOF).
+		Op("repository.Get").
+		Public("not found").
+		Severity(SeverityWarning).
+		Err().(*detailedError)
+
+	assert.Equal(t, ClInvalidIndex, err.Class())
+	assert.Equal(t, "model: 'user' not found", err.Error())
+	assert.Equal(t, "The model: 'user' is not registered.", err.Details())
+	assert.Equal(t, map[string]interface{}{"model": "user"}, err.Fields())
+	assert.Equal(t, "github.com/neuronlabs/errors.TestBuilder#builder_test.go:16|repository.Get", err.Operation())
+	assert.Equal(t, "not found", err.PublicMessage())
+	assert.Equal(t, SeverityWarning, err.Severity())
+	assert.True(t, stderrors.Is(err, io.EOF))
+	assert.Equal(t, io.EOF, stderrors.Unwrap(err))
+	assert.Contains(t, fmt.Sprintf("%+v", err), "\n\tcause: EOF")
+
+	caused := Build(ClInvalidMajor).Cause(io.ErrUnexpectedEOF).Detail("reading input").Err()
+	assert.Equal(t, "unexpected EOF", caused.Error())
+	assert.Equal(t, "reading input", caused.Details())
+
+	simple := Build(ClInvalidMinor).Msg("simple").Err()
+	assert.Equal(t, "simple", simple.Error())
+	assert.Nil(t, stderrors.Unwrap(simple))
+
+	// the builder methods are no-ops once the error is built.
+	b := Build(ClInvalidMinor).Msg("built")
+	built := b.Err()
+	b.Msg("changed").Msgf("changed: %d", 1).Detail("changed").Detailf("changed: %d", 1).
+		Field("changed", true).Cause(io.EOF).Op("changed").Public("changed").Severity(SeverityCritical)
+	assert.Equal(t, "built", built.Error())
+	assert.Empty(t, built.Details())
+	assert.Empty(t, built.(*detailedError).Fields())
+	assert.Nil(t, stderrors.Unwrap(built))
+	assert.NotContains(t, built.Operation(), "changed")
+	assert.NotEqual(t, "changed", built.(*detailedError).PublicMessage())
+	assert.NotEqual(t, SeverityCritical, built.(*detailedError).Severity())
+	assert.Equal(t, built, b.Err())
+}
@@ -0,0 +1,223 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultCatalog is the package default message catalog used by the Localize function.
+var DefaultCatalog = NewCatalog("en")
+
+// Localize renders the message and details of provided 'err' for given 'lang' language
+// using the DefaultCatalog.
+func Localize(err error, lang string) Localized {
+	return DefaultCatalog.Localize(err, lang)
+}
+
+// Localized is the localized error message and details.
+type Localized struct {
+	Language string
+	Message  string
+	Details  string
+}
+
+// Message is the localized message template defined for the Class.
+// Both the message and details might contain named parameters in a form of '{name}'.
+// The parameters are replaced with the error fields while localizing.
+type Message struct {
+	Message string `json:"message"`
+	Details string `json:"details,omitempty"`
+}
+
+// CatalogFile is the JSON encoded catalog file definition.
+// The keys of the Messages are the classes in a decimal form i.e. '16793600'
+// or in a form of dot separated major, minor and index i.e. '1.2.3'.
+type CatalogFile struct {
+	Language string             `json:"language"`
+	Messages map[string]Message `json:"messages"`
+}
+
+// Catalog is the localized message catalog.
+// It maps the classes to the message templates for each language.
+// While looking for the message it checks the error class and then its minor
+// and major classes. If the language has no message for given class,
+// it is being searched within the language fallback chain i.e. 'pt-BR' -> 'pt'
+// and at the end within the catalog default language.
+type Catalog struct {
+	mu sync.RWMutex
+
+	defaultLang string
+	languages   map[string]map[Class]Message
+}
+
+// NewCatalog creates new message catalog with provided 'defaultLang' default language.
+func NewCatalog(defaultLang string) *Catalog {
+	return &Catalog{
+		defaultLang: normalizeLanguage(defaultLang),
+		languages:   map[string]map[Class]Message{},
+	}
+}
+
+// DefaultLanguage gets the catalog default language.
+func (c *Catalog) DefaultLanguage() string {
+	return c.defaultLang
+}
+
+// Set sets the 'msg' Message for the 'class' in given 'lang' language.
+func (c *Catalog) Set(lang string, class Class, msg Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(normalizeLanguage(lang), class, msg)
+}
+
+// LoadJSON loads the JSON encoded CatalogFile from the 'r' reader.
+// If the file doesn't define its language, the 'lang' is used.
+func (c *Catalog) LoadJSON(r io.Reader, lang string) error {
+	file := CatalogFile{}
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return err
+	}
+	if file.Language == "" {
+		file.Language = lang
+	}
+	if file.Language == "" {
+		return New(ClInvalidCatalog, "catalog language not defined")
+	}
+
+	messages := make(map[Class]Message, len(file.Messages))
+	for key, msg := range file.Messages {
+		class, ok := ParseClassKey(key)
+		if !ok {
+			return Newf(ClInvalidCatalog, "invalid catalog class key: '%s'", key)
+		}
+		messages[class] = msg
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lang = normalizeLanguage(file.Language)
+	for class, msg := range messages {
+		c.set(lang, class, msg)
+	}
+	return nil
+}
+
+// LoadFiles loads the JSON encoded catalog files from provided 'paths'.
+// If a file doesn't define its language, it is taken from the file name i.e. 'pt-BR.json'.
+func (c *Catalog) LoadFiles(paths ...string) error {
+	for _, path := range paths {
+		if err := c.loadFile(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Localize renders the message and details of provided 'err' for given 'lang' language.
+// The named parameters of the message are taken from the error fields.
+// If the matched message has no details defined, the localized details are empty.
+// If no message is defined for the error class, the error message and details are returned.
+// The errors wrapping a classified error are localized by the class of the wrapped error.
+// A nil 'err' is localized as the zero value Localized.
+func (c *Catalog) Localize(err error, lang string) Localized {
+	if err == nil {
+		return Localized{}
+	}
+	localized := Localized{Message: err.Error()}
+	classError, ok := AsClassError(err)
+	if !ok {
+		return localized
+	}
+	if detailer, ok := classError.(Detailer); ok {
+		localized.Details = detailer.Details()
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, language := range c.fallbacks(lang) {
+		messages, ok := c.languages[language]
+		if !ok {
+			continue
+		}
+		for _, class := range classError.Class().Hierarchy() {
+			msg, ok := messages[class]
+			if !ok {
+				continue
+			}
+			var fields map[string]interface{}
+			if fielder, ok := classError.(Fielder); ok {
+				fields = fielder.Fields()
+			}
+			localized.Language = language
+			localized.Message = replaceParameters(msg.Message, fields)
+			// the untranslated internal details are never mixed with the localized message.
+			localized.Details = replaceParameters(msg.Details, fields)
+			return localized
+		}
+	}
+	return localized
+}
+
+func (c *Catalog) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	base := filepath.Base(path)
+	return c.LoadJSON(f, strings.TrimSuffix(base, filepath.Ext(base)))
+}
+
+func (c *Catalog) set(lang string, class Class, msg Message) {
+	messages, ok := c.languages[lang]
+	if !ok {
+		messages = map[Class]Message{}
+		c.languages[lang] = messages
+	}
+	messages[class] = msg
+}
+
+// fallbacks gets the language fallback chain i.e.: 'de-CH-1996' -> 'de-CH' -> 'de' -> default language.
+func (c *Catalog) fallbacks(lang string) []string {
+	var chain []string
+	lang = normalizeLanguage(lang)
+	for lang != "" {
+		chain = append(chain, lang)
+		i := strings.LastIndexByte(lang, '-')
+		if i == -1 {
+			break
+		}
+		lang = lang[:i]
+	}
+	for _, language := range chain {
+		if language == c.defaultLang {
+			return chain
+		}
+	}
+	return append(chain, c.defaultLang)
+}
+
+func normalizeLanguage(lang string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(lang), "_", "-", -1))
+}
+
+// replaceParameters replaces the '{name}' parameters in the 'text' with the 'params' values.
+func replaceParameters(text string, params map[string]interface{}) string {
+	if len(params) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCatalog tests the localized message catalogs.
+func TestCatalog(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	mnr := MustNewMinor(mjr)
+	clNotFound := MustNewClassWIndex(mjr, mnr)
+	clOther := MustNewClassWIndex(mjr, MustNewMinor(mjr))
+
+	catalog := NewCatalog("en")
+	require.NoError(t, catalog.LoadFiles("testdata/catalog/pl.json", "testdata/catalog/pt-BR.json"))
+	catalog.Set("en", MustNewMinorClass(mjr, mnr), Message{Message: "model: '{model}' not found"})
+
+	err := NewDet(clNotFound, "model: 'user' not found in the repository").(*detailedError)
+	err.SetDetails("Internal details.")
+	err.SetField("model", "user")
+
+	t.Run("Exact", func(t *testing.T) {
+		localized := catalog.Localize(err, "pt-BR")
+		assert.Equal(t, "pt-br", localized.Language)
+		assert.Equal(t, "Modelo não encontrado: 'user'", localized.Message)
+		assert.Empty(t, localized.Details)
+	})
+
+	t.Run("Minor", func(t *testing.T) {
+		localized := catalog.Localize(err, "pl_PL")
+		assert.Equal(t, "pl", localized.Language)
+		assert.Equal(t, "Nie znaleziono modelu: 'user'", localized.Message)
+		assert.Equal(t, "Model 'user' nie jest zarejestrowany.", localized.Details)
+	})
+
+	t.Run("Major", func(t *testing.T) {
+		localized := catalog.Localize(New(clOther, "other"), "pl")
+		assert.Equal(t, "Błąd repozytorium", localized.Message)
+	})
+
+	t.Run("DefaultLanguage", func(t *testing.T) {
+		localized := catalog.Localize(err, "de-CH-1996")
+		assert.Equal(t, "en", localized.Language)
+		assert.Equal(t, "model: 'user' not found", localized.Message)
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		localized := catalog.Localize(fmt.Errorf("handler: %w", err), "pl")
+		assert.Equal(t, "pl", localized.Language)
+		assert.Equal(t, "Nie znaleziono modelu: 'user'", localized.Message)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Equal(t, Localized{}, catalog.Localize(nil, "en"))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		localized := catalog.Localize(New(clOther, "other"), "en")
+		assert.Equal(t, "", localized.Language)
+		assert.Equal(t, "other", localized.Message)
+	})
+
+	t.Run("InvalidFile", func(t *testing.T) {
+		err := catalog.LoadJSON(strings.NewReader(`{"messages": {"1.2": {"message": "invalid"}}}`), "en")
+		require.Error(t, err)
+		assert.Equal(t, ClInvalidCatalog, err.(ClassError).Class())
+
+		err = catalog.LoadJSON(strings.NewReader(`{"messages": {}}`), "")
+		require.Error(t, err)
+
+		assert.Error(t, catalog.LoadFiles("testdata/catalog/not-existing.json"))
+	})
+}
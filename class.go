@@ -1,5 +1,10 @@
 package errors
 
+import (
+	"strconv"
+	"strings"
+)
+
 const (
 	majorBitSize = 8
 	minorBitSize = 10
@@ -24,6 +29,9 @@ func initClasses() {
 
 	invalidIndex, _ := container.newMinor(internalMajor)
 	ClInvalidIndex = MustNewMinorClass(internalMajor, invalidIndex)
+
+	invalidCatalog, _ := container.newMinor(internalMajor)
+	ClInvalidCatalog = MustNewMinorClass(internalMajor, invalidCatalog)
 }
 
 var (
@@ -33,6 +41,8 @@ var (
 	ClInvalidMinor Class
 	// ClInvalidIndex defines the invalid index error classification.
 	ClInvalidIndex Class
+	// ClInvalidCatalog defines the invalid message catalog error classification.
+	ClInvalidCatalog Class
 )
 
 // Class is the  error classification model.
@@ -186,3 +196,129 @@ func newClass(mjr Major, mnr Minor, index Index) (Class, error) {
 	}
 	return Class(uint32(mjr)<<(32-majorBitSize) | uint32(mnr)<<(32-minorBitSize-majorBitSize) | uint32(index)), nil
 }
+
+// SetClassName sets the human readable 'name' of the 'c' Class.
+// The 'c' might be the major, minor or index class.
+func SetClassName(c Class, name string) {
+	container.setInfo(c, func(info *classInfo) {
+		info.name = name
+	})
+}
+
+// ClassName gets the human readable name of the 'c' Class.
+// Returns empty string if the class has no name defined.
+// The name is not inherited from the class minor or major.
+func ClassName(c Class) string {
+	info, _ := container.getInfo(c)
+	return info.name
+}
+
+// SetClassOwner sets the 'owner' of the 'c' Class i.e. the team or service responsible for its errors.
+// The owner is also used by the classes with the same minor or major
+// unless they have their own owner defined.
+func SetClassOwner(c Class, owner string) {
+	container.setInfo(c, func(info *classInfo) {
+		info.owner = owner
+	})
+}
+
+// ClassOwner gets the owner defined for the 'c' Class, its minor or major.
+// Returns empty string if none of them has the owner defined.
+func ClassOwner(c Class) string {
+	var owner string
+	container.findInfo(c, func(info *classInfo) bool {
+		if info.owner == "" {
+			return false
+		}
+		owner = info.owner
+		return true
+	})
+	return owner
+}
+
+// RegisteredClasses gets the sorted registered major, minor and index classes
+// together with the classes that have any name, public message, severity or owner defined.
+func RegisteredClasses() []Class {
+	return container.registered()
+}
+
+// Hierarchy gets the class followed by its minor and major classes.
+// The minor class is included only if the 'c' has non zero index
+// and the major class only if the 'c' is not a major class itself.
+// It is the order in which the class metadata is inherited.
+func (c Class) Hierarchy() []Class {
+	classes := []Class{c}
+	if c.index() != 0 {
+		classes = append(classes, c.MinorClass())
+	}
+	if c.minor() != 0 {
+		classes = append(classes, c.MajorClass())
+	}
+	return classes
+}
+
+// MajorClass gets the major class of the 'c' Class - the class with its major and zero valued minor and index.
+func (c Class) MajorClass() Class {
+	return Class(uint32(c.major()) << (32 - majorBitSize))
+}
+
+// MinorClass gets the minor class of the 'c' Class - the class with its major, minor and zero valued index.
+func (c Class) MinorClass() Class {
+	return c &^ maxIndexValue
+}
+
+// contains checks if the 'o' Class is equal to 'c' or if it belongs to 'c'
+// when it is a major or minor class.
+func (c Class) contains(o Class) bool {
+	switch {
+	case c.minor() == 0 && c.index() == 0:
+		return c.major() == o.major()
+	case c.index() == 0:
+		return c.major() == o.major() && c.minor() == o.minor()
+	default:
+		return c == o
+	}
+}
+
+// Key formats the class into a dot separated major, minor and index i.e. '1.2.3'.
+func (c Class) Key() string {
+	return strconv.Itoa(int(c.major())) + "." + strconv.Itoa(int(c.minor())) + "." + strconv.Itoa(int(c.index()))
+}
+
+// ParseClassKey parses the class key in a decimal form i.e. '16793600'
+// or in a form of dot separated major, minor and index i.e. '1.2.3'.
+// Returns false if the key is not a valid class key.
+func ParseClassKey(key string) (Class, bool) {
+	parts := strings.Split(key, ".")
+	if len(parts) == 1 {
+		v, err := strconv.ParseUint(key, 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		return Class(v), true
+	}
+	if len(parts) != 3 {
+		return 0, false
+	}
+
+	var values [3]uint64
+	for i, part := range parts {
+		v, err := strconv.ParseUint(part, 10, 16)
+		if err != nil {
+			return 0, false
+		}
+		values[i] = v
+	}
+
+	mjr, mnr, index := Major(values[0]), Minor(values[1]), Index(values[2])
+	if values[0] > 0xff || !mjr.Valid() {
+		return 0, false
+	}
+	switch {
+	case mnr == 0 && index == 0:
+		return Class(uint32(mjr) << (32 - majorBitSize)), true
+	case !mnr.Valid() || (index != 0 && !index.Valid()):
+		return 0, false
+	}
+	return Class(uint32(mjr)<<(32-majorBitSize) | uint32(mnr)<<(32-minorBitSize-majorBitSize) | uint32(index)), true
+}
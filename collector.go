@@ -0,0 +1,113 @@
+package errors
+
+import (
+	"context"
+	"sync"
+)
+
+// Collector is the goroutine safe errors collector.
+// It gathers the errors into a MultiError with an optional maximum number of errors
+// and allows to cancel the contexts when an error of given classes is added.
+type Collector struct {
+	mu     sync.Mutex
+	errors MultiError
+	max    int
+	stops  []*stopCondition
+}
+
+type stopCondition struct {
+	classes []Class
+	cancel  context.CancelFunc
+	done    bool
+}
+
+// NewCollector creates new errors Collector that collects at most 'max' errors.
+// If the 'max' is lower or equal to zero the number of errors is not limited.
+func NewCollector(max int) *Collector {
+	return &Collector{max: max}
+}
+
+// CancelOn sets up the 'cancel' function to be called on the first collected error
+// of provided 'classes'. The classes are matched the same way as in the MultiError Contains method.
+// If no classes are provided the 'cancel' function is called on the first collected error.
+func (c *Collector) CancelOn(cancel context.CancelFunc, classes ...Class) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stops = append(c.stops, &stopCondition{classes: classes, cancel: cancel})
+}
+
+// Add adds the 'err' to the collector. Nil errors are skipped and the
+// errors of the MultiError are added one by one.
+// Returns false if the error, or any of the MultiError errors, was not collected
+// because the collector reached its maximum number of errors.
+// The stop conditions are matched against every added error, even the ones not collected.
+func (c *Collector) Add(err error) bool {
+	var cancels []context.CancelFunc
+
+	c.mu.Lock()
+	collected := true
+	for _, e := range Append(err) {
+		cancels = append(cancels, c.matchStops(e)...)
+		if c.max > 0 && len(c.errors) >= c.max {
+			collected = false
+			continue
+		}
+		c.errors = append(c.errors, e)
+	}
+	c.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+	return collected
+}
+
+// Len gets the number of collected errors.
+func (c *Collector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.errors)
+}
+
+// Err returns the collected errors as a MultiError or nil if no error was collected.
+func (c *Collector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.errors) == 0 {
+		return nil
+	}
+	m := make(MultiError, len(c.errors))
+	copy(m, c.errors)
+	return m
+}
+
+func (c *Collector) matchStops(err error) []context.CancelFunc {
+	var cancels []context.CancelFunc
+	for _, stop := range c.stops {
+		if stop.done || !stop.matches(err) {
+			continue
+		}
+		stop.done = true
+		cancels = append(cancels, stop.cancel)
+	}
+	return cancels
+}
+
+func (s *stopCondition) matches(err error) bool {
+	if len(s.classes) == 0 {
+		return true
+	}
+	class, ok := classOf(err)
+	if !ok {
+		return false
+	}
+	for _, c := range s.classes {
+		if c.contains(class) {
+			return true
+		}
+	}
+	return false
+}
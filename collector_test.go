@@ -0,0 +1,94 @@
+package errors
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCollector tests the goroutine safe errors collector.
+func TestCollector(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	clFatal := MustNewMinorClass(mjr, MustNewMinor(mjr))
+	clMinor := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	t.Run("Concurrent", func(t *testing.T) {
+		c := NewCollector(0)
+		assert.NoError(t, c.Err())
+
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if i%2 == 0 {
+					c.Add(Newf(clMinor, "error: %d", i))
+				} else {
+					c.Add(nil)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, 25, c.Len())
+		err := c.Err()
+		require.Error(t, err)
+
+		m, ok := err.(MultiError)
+		require.True(t, ok)
+		assert.Len(t, m, 25)
+		assert.Equal(t, []Class{clMinor}, m.Classes())
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		c := NewCollector(2)
+		assert.True(t, c.Add(io.EOF))
+		assert.False(t, c.Add(MultiError{New(clMinor, "first"), New(clMinor, "second")}))
+		assert.False(t, c.Add(io.ErrUnexpectedEOF))
+		assert.Equal(t, 2, c.Len())
+	})
+
+	t.Run("CancelOn", func(t *testing.T) {
+		c := NewCollector(0)
+
+		fatalCtx, cancelFatal := context.WithCancel(context.Background())
+		defer cancelFatal()
+		c.CancelOn(cancelFatal, clFatal)
+
+		anyCtx, cancelAny := context.WithCancel(context.Background())
+		defer cancelAny()
+		c.CancelOn(cancelAny)
+
+		c.Add(io.EOF)
+		assert.Error(t, anyCtx.Err())
+		assert.NoError(t, fatalCtx.Err())
+
+		c.Add(New(clMinor, "minor"))
+		assert.NoError(t, fatalCtx.Err())
+
+		c.Add(NewDet(clFatal, "fatal"))
+		assert.Equal(t, context.Canceled, fatalCtx.Err())
+	})
+
+	t.Run("CancelOnMax", func(t *testing.T) {
+		c := NewCollector(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c.CancelOn(cancel, clFatal)
+
+		assert.True(t, c.Add(New(clMinor, "minor")))
+		assert.NoError(t, ctx.Err())
+
+		// the stop conditions are matched even if the error is not collected.
+		assert.False(t, c.Add(New(clFatal, "fatal")))
+		assert.Equal(t, context.Canceled, ctx.Err())
+		assert.Equal(t, 1, c.Len())
+	})
+}
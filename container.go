@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"sort"
 	"sync"
 )
 
@@ -13,45 +14,147 @@ type classContainer struct {
 	major   Major
 	minors  []Minor
 	indexes [][]Index
+	classes map[Class]*classInfo
 }
 
+// classInfo is the metadata defined for a single class.
+type classInfo struct {
+	name          string
+	publicMessage string
+	severity      Severity
+	owner         string
+}
+
+// setInfo sets the class info of the 'class' using 'set' function.
+func (c *classContainer) setInfo(class Class, set func(info *classInfo)) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.classes == nil {
+		c.classes = map[Class]*classInfo{}
+	}
+	info, ok := c.classes[class]
+	if !ok {
+		info = &classInfo{}
+		c.classes[class] = info
+	}
+	set(info)
+}
+
+// getInfo gets the copy of the class info defined exactly for the 'class'.
+func (c *classContainer) getInfo(class Class) (classInfo, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	info, ok := c.classes[class]
+	if !ok {
+		return classInfo{}, false
+	}
+	return *info, true
+}
+
+// findInfo walks through the 'class', its minor and major class infos
+// until the 'match' function returns true. Returns false if no info matched.
+func (c *classContainer) findInfo(class Class, match func(info *classInfo) bool) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, cl := range class.Hierarchy() {
+		if info, ok := c.classes[cl]; ok && match(info) {
+			return true
+		}
+	}
+	return false
+}
+
+// registered gets the sorted registered major, minor and index classes
+// together with the classes that have any info defined.
+func (c *classContainer) registered() []Class {
+	c.Lock()
+	defer c.Unlock()
+
+	set := map[Class]struct{}{}
+	for mjr := 1; mjr <= int(c.major); mjr++ {
+		set[Class(uint32(mjr)<<(32-majorBitSize))] = struct{}{}
+		if mjr >= len(c.minors) {
+			continue
+		}
+		for mnr := 1; mnr <= int(c.minors[mjr]); mnr++ {
+			minorClass := Class(uint32(mjr)<<(32-majorBitSize) | uint32(mnr)<<(32-minorBitSize-majorBitSize))
+			set[minorClass] = struct{}{}
+			if mjr >= len(c.indexes) || mnr >= len(c.indexes[mjr]) {
+				continue
+			}
+			for index := 1; index <= int(c.indexes[mjr][mnr]); index++ {
+				set[minorClass|Class(index)] = struct{}{}
+			}
+		}
+	}
+	for class := range c.classes {
+		set[class] = struct{}{}
+	}
+
+	classes := make([]Class, 0, len(set))
+	for class := range set {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+	return classes
+}
+
+// newMajor creates new major. The errors are created after the container is unlocked,
+// so that the hooks might use the container while being notified.
 func (c *classContainer) newMajor() (Major, error) {
+	mjr, ok := c.nextMajor()
+	if !ok {
+		return 0, New(ClInvalidMajor, "reached maximum number of 'Major' classes")
+	}
+	return mjr, nil
+}
+
+func (c *classContainer) nextMajor() (Major, bool) {
 	c.Lock()
 	defer c.Unlock()
 
 	if c.major+1 == 0 {
-		return 0, New(ClInvalidMajor, "reached maximum number of 'Major' classes")
+		return 0, false
 	}
 	c.major++
 
 	c.resizeMinors(c.major)
 	c.resizeIndexesMajor(c.major)
-	return c.major, nil
+	return c.major, true
 }
 
+// newMinor creates new minor for the 'mjr' Major. The errors are created after the container is unlocked.
 func (c *classContainer) newMinor(mjr Major) (Minor, error) {
-	c.Lock()
-	defer c.Unlock()
-
 	if !mjr.Valid() {
 		return 0, New(ClInvalidMajor, "provided invalid Major")
 	}
+	mnr, ok := c.nextMinor(mjr)
+	if !ok {
+		return 0, Newf(ClInvalidMinor, "created maximum number of minors for major: '%d'", mjr)
+	}
+	return mnr, nil
+}
+
+func (c *classContainer) nextMinor(mjr Major) (Minor, bool) {
+	c.Lock()
+	defer c.Unlock()
 
 	c.resizeMinors(mjr)
 
 	if c.minors[mjr] == maxMinorValue {
-		return 0, Newf(ClInvalidMinor, "created maximum number of minors for major: '%d'", mjr)
+		return 0, false
 	}
 	c.minors[mjr]++
 
 	c.resizeIndexesMinors(mjr, c.minors[mjr])
-	return c.minors[mjr], nil
+	return c.minors[mjr], true
 }
 
+// newIndex creates new index for the 'mjr' Major and 'mnr' Minor. The errors are created after the container is unlocked.
 func (c *classContainer) newIndex(mjr Major, mnr Minor) (Index, error) {
-	c.Lock()
-	defer c.Unlock()
-
 	if !mjr.Valid() {
 		return 0, New(ClInvalidMajor, "provided invalid Major")
 	}
@@ -60,14 +163,25 @@ func (c *classContainer) newIndex(mjr Major, mnr Minor) (Index, error) {
 		return 0, New(ClInvalidMinor, "provided invalid Minor")
 	}
 
+	index, ok := c.nextIndex(mjr, mnr)
+	if !ok {
+		return 0, Newf(ClInvalidIndex, "reached maximum index subclass number for mjr: '%d', mnr: '%d'", mjr, mnr)
+	}
+	return index, nil
+}
+
+func (c *classContainer) nextIndex(mjr Major, mnr Minor) (Index, bool) {
+	c.Lock()
+	defer c.Unlock()
+
 	c.resizeIndexesMajor(mjr)
 	c.resizeIndexesMinors(mjr, mnr)
 
 	if c.indexes[mjr][mnr] == maxIndexValue {
-		return 0, Newf(ClInvalidIndex, "reached maximum index subclass number for mjr: '%d', mnr: '%d'", mjr, mnr)
+		return 0, false
 	}
 	c.indexes[mjr][mnr]++
-	return c.indexes[mjr][mnr], nil
+	return c.indexes[mjr][mnr], true
 }
 
 func (c *classContainer) resizeMinors(mjr Major) {
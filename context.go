@@ -0,0 +1,90 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ContextExtractor is the function that extracts a value from the context.
+// Returns false if the context doesn't contain the value.
+type ContextExtractor func(ctx context.Context) (interface{}, bool)
+
+var contextExtractors = struct {
+	sync.RWMutex
+	extractors map[string]ContextExtractor
+}{extractors: map[string]ContextExtractor{}}
+
+// RegisterContextExtractor registers the 'extractor' which value is set as the 'field'
+// of the errors created with the context aware constructors.
+// Registering an extractor for already registered field replaces it.
+func RegisterContextExtractor(field string, extractor ContextExtractor) {
+	contextExtractors.Lock()
+	defer contextExtractors.Unlock()
+
+	contextExtractors.extractors[field] = extractor
+}
+
+// UnregisterContextExtractor removes the context extractor registered for the 'field'.
+func UnregisterContextExtractor(field string) {
+	contextExtractors.Lock()
+	defer contextExtractors.Unlock()
+
+	delete(contextExtractors.extractors, field)
+}
+
+type operationsKey struct{}
+
+// WithOperation creates a context with the 'operation' name pushed onto the context operations path.
+// The errors created with the context aware constructors records the operations path.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	parent := ContextOperations(ctx)
+	operations := make([]string, len(parent), len(parent)+1)
+	copy(operations, parent)
+	return context.WithValue(ctx, operationsKey{}, append(operations, operation))
+}
+
+// ContextOperations gets the operations path stored in the 'ctx' starting from the outermost operation.
+func ContextOperations(ctx context.Context) []string {
+	operations, _ := ctx.Value(operationsKey{}).([]string)
+	return operations
+}
+
+// NewDetCtx creates DetailedError with given 'class' and message 'message'.
+// The error fields are set with the values of the registered context extractors
+// and the context operations path is appended to the error operations.
+func NewDetCtx(ctx context.Context, c Class, message string) DetailedError {
+	err := newDetailed(c)
+	err.message = message
+	annotateContext(ctx, err)
+	notifyCreated(err)
+	return err
+}
+
+// NewDetCtxf creates DetailedError instance with provided 'class' with formatted message.
+// The error fields are set with the values of the registered context extractors
+// and the context operations path is appended to the error operations.
+func NewDetCtxf(ctx context.Context, c Class, format string, args ...interface{}) DetailedError {
+	err := newDetailed(c)
+	err.message = fmt.Sprintf(format, args...)
+	annotateContext(ctx, err)
+	notifyCreated(err)
+	return err
+}
+
+// annotateContext sets the 'err' fields from the context extractors and appends
+// the context operations in order from the innermost one.
+func annotateContext(ctx context.Context, err *detailedError) {
+	contextExtractors.RLock()
+	for field, extractor := range contextExtractors.extractors {
+		if value, ok := extractor(ctx); ok {
+			err.SetField(field, value)
+		}
+	}
+	contextExtractors.RUnlock()
+
+	operations := ContextOperations(ctx)
+	for i := len(operations) - 1; i >= 0; i-- {
+		err.AppendOperation(operations[i])
+	}
+}
@@ -0,0 +1,41 @@
+package errors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRequestIDKey struct{}
+
+// TestContextErrors tests the context aware error constructors.
+func TestContextErrors(t *testing.T) {
+	resetContainer()
+
+	RegisterContextExtractor("request_id", func(ctx context.Context) (interface{}, bool) {
+		v, ok := ctx.Value(testRequestIDKey{}).(string)
+		return v, ok
+	})
+	defer UnregisterContextExtractor("request_id")
+
+	ctx := context.WithValue(context.Background(), testRequestIDKey{}, "req-1")
+	ctx = WithOperation(ctx, "controller.Create")
+	inner := WithOperation(ctx, "repository.Insert")
+	other := WithOperation(ctx, "repository.Update")
+
+	assert.Equal(t, []string{"controller.Create"}, ContextOperations(ctx))
+	assert.Equal(t, []string{"controller.Create", "repository.Insert"}, ContextOperations(inner))
+	assert.Equal(t, []string{"controller.Create", "repository.Update"}, ContextOperations(other))
+	assert.Nil(t, ContextOperations(context.Background()))
+
+	err := NewDetCtx(inner, ClInvalidIndex, "insert failed").(*detailedError)
+	assert.Equal(t, "insert failed", err.Error())
+	assert.Equal(t, map[string]interface{}{"request_id": "req-1"}, err.Fields())
+	assert.Regexp(t, `^github.com/neuronlabs/errors.TestContextErrors#context_test.go:\d+\|repository.Insert\|controller.Create$`, err.Operation())
+
+	err = NewDetCtxf(context.Background(), ClInvalidIndex, "update: %d", 1).(*detailedError)
+	assert.Equal(t, "update: 1", err.Error())
+	assert.Nil(t, err.Fields())
+	assert.Regexp(t, `^github.com/neuronlabs/errors.TestContextErrors#context_test.go:\d+$`, err.Operation())
+}
@@ -0,0 +1,64 @@
+package errors
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var counters = &classCounters{}
+
+// classCounters are the lock-free per class error counters.
+type classCounters struct {
+	enabled int32
+	counts  sync.Map
+}
+
+// EnableCounters enables counting the errors created for each class.
+// The counters might be published with the expvar package by importing the errexpvar subpackage.
+func EnableCounters() {
+	atomic.StoreInt32(&counters.enabled, 1)
+}
+
+// DisableCounters disables counting the created errors. The already counted values are preserved.
+func DisableCounters() {
+	atomic.StoreInt32(&counters.enabled, 0)
+}
+
+// ResetCounters sets all the error counters to zero.
+func ResetCounters() {
+	counters.counts.Range(func(key, _ interface{}) bool {
+		counters.counts.Delete(key)
+		return true
+	})
+}
+
+// ClassCount gets the number of counted errors of the 'c' Class.
+func ClassCount(c Class) uint64 {
+	v, ok := counters.counts.Load(c)
+	if !ok {
+		return 0
+	}
+	return atomic.LoadUint64(v.(*uint64))
+}
+
+// ClassCounts gets the number of counted errors for each class.
+func ClassCounts() map[Class]uint64 {
+	counts := map[Class]uint64{}
+	counters.counts.Range(func(key, value interface{}) bool {
+		counts[key.(Class)] = atomic.LoadUint64(value.(*uint64))
+		return true
+	})
+	return counts
+}
+
+func (c *classCounters) inc(class Class) {
+	if atomic.LoadInt32(&c.enabled) == 0 {
+		return
+	}
+
+	v, ok := c.counts.Load(class)
+	if !ok {
+		v, _ = c.counts.LoadOrStore(class, new(uint64))
+	}
+	atomic.AddUint64(v.(*uint64), 1)
+}
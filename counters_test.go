@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCounters tests the per class error counters.
+func TestCounters(t *testing.T) {
+	resetContainer()
+	ResetCounters()
+
+	mjr := MustNewMajor()
+	mnr := MustNewMinor(mjr)
+	clFirst := MustNewClassWIndex(mjr, mnr)
+	clSecond := MustNewMinorClass(mjr, MustNewMinor(mjr))
+	SetClassName(clFirst, "first")
+	SetClassName(MustNewMajorClass(mjr), "repository")
+
+	New(clFirst, "not counted")
+	assert.Equal(t, uint64(0), ClassCount(clFirst))
+
+	EnableCounters()
+	defer DisableCounters()
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			New(clFirst, "simple")
+			Newf(clFirst, "simple: %d", 1)
+			NewDet(clSecond, "detailed")
+			NewDetf(clSecond, "detailed: %d", 1)
+		}()
+	}
+	wg.Wait()
+
+	NewDetCtx(context.Background(), clFirst, "context")
+	NewTemplate(clFirst, "template").New()
+	b := Build(clSecond).Cause(io.EOF)
+	b.Err()
+	b.Err()
+
+	assert.Equal(t, uint64(22), ClassCount(clFirst))
+	assert.Equal(t, uint64(21), ClassCount(clSecond))
+	assert.Equal(t, map[Class]uint64{clFirst: 22, clSecond: 21}, ClassCounts())
+
+	DisableCounters()
+	New(clFirst, "not counted")
+	assert.Equal(t, uint64(22), ClassCount(clFirst))
+
+	ResetCounters()
+	assert.Equal(t, uint64(0), ClassCount(clFirst))
+	assert.Empty(t, ClassCounts())
+}
@@ -1,44 +1,77 @@
 package errors
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 // compile time check for detailedError interfaces.
 var (
-	_ ClassError    = &detailedError{}
-	_ Detailer      = &detailedError{}
-	_ Operationer   = &detailedError{}
-	_ Indexer       = &detailedError{}
-	_ DetailedError = &detailedError{}
+	_ ClassError     = &detailedError{}
+	_ Detailer       = &detailedError{}
+	_ Operationer    = &detailedError{}
+	_ Fielder        = &detailedError{}
+	_ PublicMessager = &detailedError{}
+	_ Severitier     = &detailedError{}
+	_ Timestamper    = &detailedError{}
+	_ fmt.Formatter  = &detailedError{}
+	_ json.Marshaler = &detailedError{}
+	_ Indexer        = &detailedError{}
+	_ DetailEntrier  = &detailedError{}
+	_ DetailedError  = &detailedError{}
 )
 
+// clock is the time source used for the detailed errors timestamps.
+// It is replaceable for the testing purpose.
+var clock = time.Now
+
 // detailedError is the class based error definition.
 // Each instance has it's own trackable ID. It's chainable
 // It contains also a Class variable that might be comparable in logic.
+// The mutable error information is guarded by the mutex, so that
+// the error might be annotated by multiple goroutines at once.
 type detailedError struct {
+	mu sync.RWMutex
 	// ID is a unique error instance identification number.
 	id uuid.UUID
 	// class defines the error classification.
 	class Class
-	// details contains the detailed information.
-	details string
+	// timestamp is the time when the error was created.
+	timestamp time.Time
+	// details contains the detailed information entries in order of their occurrence in the details message.
+	details []Detail
 	// message is a message used as a string for the
 	// golang error interface implementation.
 	message string
 	// Opertaion is the operation name when the error occurred.
 	operation string
+	// publicMessage is the message safe to be shown to the external consumers.
+	publicMessage string
+	// severity is the error instance severity that overrides the class severity.
+	severity Severity
+	// fields are the key-value pairs attached to given error instance.
+	fields map[string]interface{}
+	// template is the Template that created given error.
+	template *Template
+	// cause is the error that caused given error.
+	cause error
 }
 
 // NewDet creates DetailedError with given 'class' and message 'message'.
 func NewDet(c Class, message string) DetailedError {
 	err := newDetailed(c)
 	err.message = message
+	notifyCreated(err)
 	return err
 }
 
@@ -47,6 +80,7 @@ func NewDet(c Class, message string) DetailedError {
 func NewDetf(c Class, format string, args ...interface{}) DetailedError {
 	err := newDetailed(c)
 	err.message = fmt.Sprintf(format, args...)
+	notifyCreated(err)
 	return err
 }
 
@@ -56,8 +90,25 @@ func (e *detailedError) Class() Class {
 }
 
 // Details implements DetailedError interface.
+// The details entries texts are joined with a space.
 func (e *detailedError) Details() string {
-	return e.details
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.joinDetails()
+}
+
+// DetailEntries implements Detailer interface.
+func (e *detailedError) DetailEntries() []Detail {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if len(e.details) == 0 {
+		return nil
+	}
+	details := make([]Detail, len(e.details))
+	copy(details, e.details)
+	return details
 }
 
 // DetailedError implements error interface.
@@ -70,58 +121,241 @@ func (e *detailedError) ID() uuid.UUID {
 	return e.id
 }
 
+// PublicMessage implements PublicMessager interface.
+// If the error has no public message set, the public message of its class is returned.
+func (e *detailedError) PublicMessage() string {
+	e.mu.RLock()
+	message := e.publicMessage
+	e.mu.RUnlock()
+
+	if message != "" {
+		return message
+	}
+	return ClassPublicMessage(e.class)
+}
+
+// SetPublicMessage implements PublicMessager interface.
+func (e *detailedError) SetPublicMessage(message string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.publicMessage = message
+}
+
+// Severity implements Severitier interface.
+// If the error has no severity set, the severity of its class is returned.
+func (e *detailedError) Severity() Severity {
+	e.mu.RLock()
+	severity := e.severity
+	e.mu.RUnlock()
+
+	if severity != 0 {
+		return severity
+	}
+	return ClassSeverity(e.class)
+}
+
+// SetSeverity implements Severitier interface.
+func (e *detailedError) SetSeverity(s Severity) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.severity = s
+}
+
+// Fields implements Fielder interface.
+// Returns a copy of the error fields.
+func (e *detailedError) Fields() map[string]interface{} {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.fields == nil {
+		return nil
+	}
+	fields := make(map[string]interface{}, len(e.fields))
+	for k, v := range e.fields {
+		fields[k] = v
+	}
+	return fields
+}
+
+// SetField implements Fielder interface.
+func (e *detailedError) SetField(key string, value interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.fields == nil {
+		e.fields = map[string]interface{}{}
+	}
+	e.fields[key] = value
+}
+
+// Unwrap gets the error that caused given error.
+// Used by the 'errors.Is' and 'errors.As' functions.
+func (e *detailedError) Unwrap() error {
+	return e.cause
+}
+
+// Is checks if the 'target' is the Template that created given error.
+// Used by the 'errors.Is' function.
+func (e *detailedError) Is(target error) bool {
+	t, ok := target.(*Template)
+	return ok && e.template != nil && e.template == t
+}
+
+// Timestamp implements Timestamper interface.
+func (e *detailedError) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// Format implements fmt.Formatter interface.
+// The '%+v' verb prints the message followed by the class, id, timestamp, details,
+// operation and fields of the error, each in a separate line.
+// Any other verb formats the message with the same verb, width and flags i.e. '%x' or '%10s'.
+func (e *detailedError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		e.mu.RLock()
+		e.formatVerbose(s)
+		e.mu.RUnlock()
+		return
+	}
+	fmt.Fprintf(s, fmt.FormatString(s, verb), e.message)
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// Only the class, id, timestamp and message of the error are marshaled, the details,
+// operation and fields are omitted. The message is the internal one and might contain
+// the internal information, use RenderPublic to render the error for the external consumers.
+func (e *detailedError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(View{
+		Class:     e.class,
+		ID:        e.id.String(),
+		Timestamp: e.timestamp.Format(time.RFC3339Nano),
+		Message:   e.message,
+	})
+}
+
 // Operation implements OperationError interface.
 func (e *detailedError) Operation() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
 	return e.operation
 }
 
-// SetDetails sets the error 'detail' and returns itself.
+// SetDetails sets the error 'detail'.
+// The detail entry operation is the function that called SetDetails.
 func (e *detailedError) SetDetails(detail string) {
-	e.details = detail
+	e.setDetail(Detail{Text: detail, Operation: callerOperation(1)})
 }
 
-// SetDetailsf sets the error's formatted detail with provided and returns itself.
+// SetDetailsf sets the error's detail formatted with provided 'format' and 'args'.
+// The detail entry operation is the function that called SetDetailsf.
 func (e *detailedError) SetDetailsf(format string, args ...interface{}) {
-	e.details = fmt.Sprintf(format, args...)
+	e.setDetail(Detail{Text: fmt.Sprintf(format, args...), Operation: callerOperation(1)})
 }
 
 // WrapDetail wraps the 'detail' for given error. Wrapping appends the new detail
 // to the front of error detail message.
+// The detail entry operation is the function that called WrapDetails.
 func (e *detailedError) WrapDetails(detail string) {
-	e.wrapDetail(detail)
+	e.wrapDetail(Detail{Text: detail, Operation: callerOperation(1)})
 }
 
 // WrapDetailf wraps the detail with provided formatting for given error.
 // Wrapping appends the new detail to the front of error detail message.
+// The detail entry operation is the function that called WrapDetailsf.
 func (e *detailedError) WrapDetailsf(format string, args ...interface{}) {
-	e.wrapDetail(fmt.Sprintf(format, args...))
+	e.wrapDetail(Detail{Text: fmt.Sprintf(format, args...), Operation: callerOperation(1)})
 }
 
 // AppendOperation wraps the 'operation' by concantinating 'e' Operation
 // to its value. It create a chain of operation call.
 func (e *detailedError) AppendOperation(operation string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
 	e.operation += "|" + operation
 }
 
-func (e *detailedError) wrapDetail(detail string) {
-	if e.details == "" {
-		e.details = detail
-	} else {
-		e.details = detail + " " + e.details
+func (e *detailedError) setDetail(detail Detail) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if detail.Text == "" {
+		e.details = nil
+		return
+	}
+	e.details = []Detail{detail}
+}
+
+func (e *detailedError) wrapDetail(detail Detail) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if detail.Text == "" {
+		return
+	}
+	e.details = append([]Detail{detail}, e.details...)
+}
+
+func (e *detailedError) joinDetails() string {
+	switch len(e.details) {
+	case 0:
+		return ""
+	case 1:
+		return e.details[0].Text
+	}
+	texts := make([]string, len(e.details))
+	for i, detail := range e.details {
+		texts[i] = detail.Text
+	}
+	return strings.Join(texts, " ")
+}
+
+func (e *detailedError) formatVerbose(w io.Writer) {
+	fmt.Fprintf(w, "%s\n\tclass: %d\n\tid: %s\n\ttimestamp: %s", e.message, e.class, e.id, e.timestamp.Format(time.RFC3339Nano))
+	if len(e.details) > 0 {
+		fmt.Fprintf(w, "\n\tdetails: %s", e.joinDetails())
+	}
+	if e.operation != "" {
+		fmt.Fprintf(w, "\n\toperation: %s", e.operation)
+	}
+	if e.cause != nil {
+		fmt.Fprintf(w, "\n\tcause: %s", e.cause)
+	}
+	if len(e.fields) > 0 {
+		keys := make([]string, 0, len(e.fields))
+		for k := range e.fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		io.WriteString(w, "\n\tfields:")
+		for _, k := range keys {
+			fmt.Fprintf(w, " %s=%v", k, e.fields[k])
+		}
 	}
 }
 
 func newDetailed(c Class) *detailedError {
 	err := &detailedError{
-		id:    uuid.New(),
-		class: c,
+		id:        uuid.New(),
+		class:     c,
+		timestamp: clock(),
+		operation: callerOperation(2),
 	}
-	pc, _, _, ok := runtime.Caller(2)
+	return err
+}
+
+// callerOperation gets the runtime operation name of the function 'skip' frames above the caller
+// of the callerOperation, in a form of 'function#file:line'.
+func callerOperation(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
 	details := runtime.FuncForPC(pc)
-	if ok && details != nil {
-		file, line := details.FileLine(pc)
-		_, singleFile := filepath.Split(file)
-		err.operation = details.Name() + "#" + singleFile + ":" + strconv.Itoa(line)
+	if !ok || details == nil {
+		return ""
 	}
-	return err
+	file, line := details.FileLine(pc)
+	_, singleFile := filepath.Split(file)
+	return details.Name() + "#" + singleFile + ":" + strconv.Itoa(line)
 }
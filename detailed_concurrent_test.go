@@ -0,0 +1,56 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDetailedErrorConcurrent tests concurrent annotation of the detailed error.
+// It should be run with the race detector enabled.
+func TestDetailedErrorConcurrent(t *testing.T) {
+	resetContainer()
+
+	err := NewDet(ClInvalidIndex, "concurrent").(*detailedError)
+	operation := err.Operation()
+
+	const workers = 20
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			err.WrapDetailsf("detail %d.", i)
+			err.AppendOperation(fmt.Sprintf("worker-%d", i))
+			err.SetField(fmt.Sprintf("worker-%d", i), i)
+			err.SetSeverity(SeverityWarning)
+			err.SetPublicMessage("public")
+
+			_ = err.Details()
+			_ = err.Operation()
+			_ = err.Fields()
+			_ = err.Severity()
+			_ = err.PublicMessage()
+			_ = fmt.Sprintf("%+v", err)
+			_, _ = json.Marshal(err)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, strings.Split(err.Details(), " "), 2*workers)
+	assert.Len(t, strings.Split(err.Operation(), "|"), workers+1)
+	assert.True(t, strings.HasPrefix(err.Operation(), operation))
+	assert.Len(t, err.Fields(), workers)
+	assert.Equal(t, SeverityWarning, err.Severity())
+	assert.Equal(t, "public", err.PublicMessage())
+
+	// the returned fields should be a copy.
+	err.Fields()["copy"] = true
+	_, ok := err.Fields()["copy"]
+	assert.False(t, ok)
+}
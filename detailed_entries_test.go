@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDetailedErrorDetailEntries tests the structured detail entries.
+func TestDetailedErrorDetailEntries(t *testing.T) {
+	resetContainer()
+
+	err := NewDet(ClInvalidIndex, "entries").(*detailedError)
+	assert.Nil(t, err.DetailEntries())
+
+	err.SetDetails("Base detail.")
+	wrapDetails := func() { err.WrapDetailsf("Wrapped %d.", 1) }
+	wrapDetails()
+	err.WrapDetails("")
+
+	entries := err.DetailEntries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Wrapped 1.", entries[0].Text)
+	assert.Regexp(t, `^github.com/neuronlabs/errors.TestDetailedErrorDetailEntries.func1#detailed_entries_test.go:\d+$`, entries[0].Operation)
+	assert.Equal(t, "Base detail.", entries[1].Text)
+	assert.Regexp(t, `^github.com/neuronlabs/errors.TestDetailedErrorDetailEntries#detailed_entries_test.go:\d+$`, entries[1].Operation)
+	assert.Equal(t, "Wrapped 1. Base detail.", err.Details())
+
+	// the returned entries should be a copy.
+	entries[0].Text = "Changed."
+	assert.Equal(t, "Wrapped 1.", err.DetailEntries()[0].Text)
+
+	err.SetDetails("")
+	assert.Nil(t, err.DetailEntries())
+	assert.Equal(t, "", err.Details())
+
+	built := Build(ClInvalidIndex).Detail("Built.").Err().(*detailedError)
+	require.Len(t, built.DetailEntries(), 1)
+	assert.Regexp(t, `^github.com/neuronlabs/errors.TestDetailedErrorDetailEntries#detailed_entries_test.go:\d+$`, built.DetailEntries()[0].Operation)
+
+	templated := NewTemplate(ClInvalidIndex, "templated").WithDetails("Template detail.").New().(*detailedError)
+	assert.Equal(t, []Detail{{Text: "Template detail.", Operation: templated.Operation()}}, templated.DetailEntries())
+}
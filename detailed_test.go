@@ -46,7 +46,7 @@ func TestDetailedError(t *testing.T) {
 	sd, ok := second.(*detailedError)
 	require.True(t, ok)
 
-	sd.details = ""
+	sd.details = nil
 	second.WrapDetails("Should be stored.")
 
 	assert.Equal(t, "Should be stored.", sd.Details())
@@ -0,0 +1,49 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDetailedErrorTimestamp tests the detailed error timestamp and its formatting.
+func TestDetailedErrorTimestamp(t *testing.T) {
+	resetContainer()
+
+	ts := time.Date(2019, 9, 1, 12, 30, 15, 0, time.UTC)
+	clock = func() time.Time { return ts }
+	defer func() { clock = time.Now }()
+
+	err := NewDet(ClInvalidIndex, "invalid index").(*detailedError)
+	err.SetDetails("Index out of range.")
+	err.SetField("index", 2)
+
+	assert.Equal(t, ts, err.Timestamp())
+
+	assert.Equal(t, "invalid index", fmt.Sprintf("%v", err))
+	assert.Equal(t, "invalid index", fmt.Sprintf("%s", err))
+	assert.Equal(t, `"invalid index"`, fmt.Sprintf("%q", err))
+	assert.Equal(t, "696e76616c696420696e646578", fmt.Sprintf("%x", err))
+	assert.Equal(t, "  invalid index", fmt.Sprintf("%15s", err))
+	assert.Equal(t, "%!d(string=invalid index)", fmt.Sprintf("%d", err))
+
+	expected := fmt.Sprintf("invalid index\n\tclass: %d\n\tid: %s\n\ttimestamp: 2019-09-01T12:30:15Z\n\tdetails: Index out of range.\n\toperation: %s\n\tfields: index=2", ClInvalidIndex, err.ID(), err.Operation())
+	assert.Equal(t, expected, fmt.Sprintf("%+v", err))
+
+	data, jsonErr := json.Marshal(err)
+	require.NoError(t, jsonErr)
+
+	view := View{}
+	require.NoError(t, json.Unmarshal(data, &view))
+	assert.Equal(t, "2019-09-01T12:30:15Z", view.Timestamp)
+	assert.Equal(t, err.ID().String(), view.ID)
+	assert.Equal(t, ClInvalidIndex, view.Class)
+	assert.Equal(t, "invalid index", view.Message)
+	assert.Empty(t, view.Details)
+	assert.Empty(t, view.Operation)
+	assert.Nil(t, view.Fields)
+}
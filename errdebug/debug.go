@@ -0,0 +1,315 @@
+// Package errdebug provides the HTTP debug handler of the github.com/neuronlabs/errors package.
+//
+// The handler serves the class registry and the recent errors recorded by the errors journal,
+// in the same manner as the net/http/pprof package serves the runtime profiles:
+//
+//	errors.EnableJournal(1000)
+//	http.Handle("/debug/errors/", errdebug.Handler("/debug/errors/"))
+package errdebug
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/neuronlabs/errors"
+)
+
+// ErrorsLimit is the default number of the recent errors served by the Handler.
+var ErrorsLimit = 100
+
+// Handler gets the http.Handler that serves the HTML and JSON views of the class registry
+// and the recent errors recorded by the journal enabled with the errors.EnableJournal function.
+// The handler is meant to be mounted at provided 'prefix' i.e.:
+//
+//	http.Handle("/debug/errors/", errdebug.Handler("/debug/errors/"))
+//
+// It serves the following paths relative to the 'prefix':
+//
+//	/                  - HTML view of the class registry and the recent errors
+//	/registry.json     - JSON view of the class registry
+//	/errors.json       - JSON view of the recent errors
+//	/errors/{id}       - HTML view of the recorded error with given id
+//	/errors/{id}.json  - JSON view of the recorded error with given id
+//
+// The recent errors might be filtered with the 'class' query parameter in a form of dot separated
+// major, minor and index i.e. '1.2.0' and limited with the 'limit' query parameter.
+func Handler(prefix string) http.Handler {
+	prefix = "/" + strings.Trim(prefix, "/")
+	if prefix != "/" {
+		prefix += "/"
+	}
+	return &debugHandler{prefix: prefix}
+}
+
+type debugHandler struct {
+	prefix string
+}
+
+// debugClass is the class registry entry served by the Handler.
+type debugClass struct {
+	Class         errors.Class `json:"class"`
+	Key           string       `json:"key"`
+	Major         errors.Major `json:"major"`
+	Minor         errors.Minor `json:"minor"`
+	Index         errors.Index `json:"index"`
+	Name          string       `json:"name,omitempty"`
+	Owner         string       `json:"owner,omitempty"`
+	PublicMessage string       `json:"public_message"`
+	Severity      string       `json:"severity"`
+	Count         uint64       `json:"count"`
+}
+
+// debugEntry is the journal entry served by the Handler.
+type debugEntry struct {
+	errors.JournalEntry
+	Key  string `json:"key"`
+	Name string `json:"name,omitempty"`
+}
+
+// debugIndex is the data of the Handler index page.
+type debugIndex struct {
+	Prefix         string
+	Classes        []debugClass
+	JournalEnabled bool
+	Filter         string
+	Limit          int
+	Entries        []debugEntry
+}
+
+// ServeHTTP implements http.Handler interface.
+func (h *debugHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		rw.Header().Set("Allow", "GET, HEAD")
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var path string
+	switch {
+	case strings.HasPrefix(req.URL.Path, h.prefix):
+		path = strings.TrimPrefix(req.URL.Path, h.prefix)
+	case req.URL.Path+"/" != h.prefix:
+		http.NotFound(rw, req)
+		return
+	}
+
+	switch {
+	case path == "":
+		h.serveIndex(rw, req)
+	case path == "registry.json":
+		writeDebugJSON(rw, debugClasses())
+	case path == "errors.json":
+		h.serveErrors(rw, req)
+	case strings.HasPrefix(path, "errors/"):
+		h.serveError(rw, req, strings.TrimPrefix(path, "errors/"))
+	default:
+		http.NotFound(rw, req)
+	}
+}
+
+func (h *debugHandler) serveIndex(rw http.ResponseWriter, req *http.Request) {
+	index := debugIndex{Prefix: h.prefix, Classes: debugClasses()}
+	if j := errors.EnabledJournal(); j != nil {
+		q, ok := debugQuery(rw, req)
+		if !ok {
+			return
+		}
+		index.JournalEnabled = true
+		index.Filter = req.URL.Query().Get("class")
+		index.Limit = q.Limit
+		index.Entries = debugEntries(j.Query(q))
+	}
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	debugIndexTemplate.Execute(rw, index)
+}
+
+func (h *debugHandler) serveErrors(rw http.ResponseWriter, req *http.Request) {
+	j := errors.EnabledJournal()
+	if j == nil {
+		http.Error(rw, "errors journal is not enabled", http.StatusNotFound)
+		return
+	}
+	q, ok := debugQuery(rw, req)
+	if !ok {
+		return
+	}
+	entries := debugEntries(j.Query(q))
+	if entries == nil {
+		entries = []debugEntry{}
+	}
+	writeDebugJSON(rw, entries)
+}
+
+func (h *debugHandler) serveError(rw http.ResponseWriter, req *http.Request, path string) {
+	j := errors.EnabledJournal()
+	if j == nil {
+		http.Error(rw, "errors journal is not enabled", http.StatusNotFound)
+		return
+	}
+	asJSON := strings.HasSuffix(path, ".json")
+	id, err := uuid.Parse(strings.TrimSuffix(path, ".json"))
+	if err != nil {
+		http.Error(rw, "invalid error id: '"+path+"'", http.StatusBadRequest)
+		return
+	}
+	entry, ok := j.Lookup(id)
+	if !ok {
+		http.Error(rw, "error not found: '"+id.String()+"'", http.StatusNotFound)
+		return
+	}
+
+	e := newDebugEntry(entry)
+	if asJSON {
+		writeDebugJSON(rw, e)
+		return
+	}
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	debugErrorTemplate.Execute(rw, struct {
+		Prefix string
+		Entry  debugEntry
+	}{Prefix: h.prefix, Entry: e})
+}
+
+// debugQuery gets the journal query from the 'class' and 'limit' request query parameters.
+// If the parameters are not valid, the bad request response is written and false is returned.
+func debugQuery(rw http.ResponseWriter, req *http.Request) (errors.JournalQuery, bool) {
+	q := errors.JournalQuery{Limit: ErrorsLimit}
+	params := req.URL.Query()
+	if key := params.Get("class"); key != "" {
+		class, ok := errors.ParseClassKey(key)
+		if !ok {
+			http.Error(rw, "invalid class: '"+key+"'", http.StatusBadRequest)
+			return q, false
+		}
+		q.Class = class
+	}
+	if limit := params.Get("limit"); limit != "" {
+		v, err := strconv.Atoi(limit)
+		if err != nil || v < 0 {
+			http.Error(rw, "invalid limit: '"+limit+"'", http.StatusBadRequest)
+			return q, false
+		}
+		q.Limit = v
+	}
+	return q, true
+}
+
+// debugClasses gets the registry entries of all registered classes.
+func debugClasses() []debugClass {
+	counts := errors.ClassCounts()
+	registered := errors.RegisteredClasses()
+	classes := make([]debugClass, len(registered))
+	for i, class := range registered {
+		classes[i] = debugClass{
+			Class:         class,
+			Key:           class.Key(),
+			Major:         class.Major(),
+			Minor:         class.Minor(),
+			Index:         class.Index(),
+			Name:          errors.ClassName(class),
+			Owner:         errors.ClassOwner(class),
+			PublicMessage: errors.ClassPublicMessage(class),
+			Severity:      errors.ClassSeverity(class).String(),
+			Count:         counts[class],
+		}
+	}
+	return classes
+}
+
+// debugEntries gets the 'entries' from the newest to the oldest.
+func debugEntries(entries []errors.JournalEntry) []debugEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+	debug := make([]debugEntry, len(entries))
+	for i, entry := range entries {
+		debug[len(entries)-1-i] = newDebugEntry(entry)
+	}
+	return debug
+}
+
+func newDebugEntry(entry errors.JournalEntry) debugEntry {
+	return debugEntry{JournalEntry: entry, Key: entry.Class.Key(), Name: errors.ClassName(entry.Class)}
+}
+
+func writeDebugJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(rw)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+const debugStyle = `<style>
+body { font-family: sans-serif; font-size: 14px; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 2px 8px; text-align: left; vertical-align: top; }
+th { background: #eee; }
+</style>`
+
+var debugIndexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>errors</title>
+` + debugStyle + `
+</head>
+<body>
+<h1>errors</h1>
+<h2>Registry</h2>
+<p><a href="{{.Prefix}}registry.json">registry.json</a></p>
+<table>
+<tr><th>Class</th><th>Key</th><th>Name</th><th>Owner</th><th>Public message</th><th>Severity</th><th>Count</th><th></th></tr>
+{{- range .Classes}}
+<tr><td>{{.Class}}</td><td>{{.Key}}</td><td>{{.Name}}</td><td>{{.Owner}}</td><td>{{.PublicMessage}}</td><td>{{.Severity}}</td><td>{{.Count}}</td><td><a href="{{$.Prefix}}?class={{.Key}}">errors</a></td></tr>
+{{- end}}
+</table>
+<h2>Recent errors</h2>
+{{- if .JournalEnabled}}
+<form method="get" action="{{.Prefix}}">
+<label>Class <input name="class" value="{{.Filter}}" placeholder="1.2.0"></label>
+<label>Limit <input name="limit" value="{{.Limit}}" size="4"></label>
+<input type="submit" value="Filter">
+<a href="{{.Prefix}}errors.json?class={{.Filter}}&amp;limit={{.Limit}}">errors.json</a>
+</form>
+<table>
+<tr><th>Timestamp</th><th>ID</th><th>Class</th><th>Name</th><th>Message</th></tr>
+{{- range .Entries}}
+<tr><td>{{.Timestamp.Format "2006-01-02T15:04:05.000Z07:00"}}</td><td><a href="{{$.Prefix}}errors/{{.ID}}">{{.ID}}</a></td><td>{{.Key}}</td><td>{{.Name}}</td><td>{{.Message}}</td></tr>
+{{- else}}
+<tr><td colspan="5">no errors</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p>The errors journal is not enabled.</p>
+{{- end}}
+</body>
+</html>
+`))
+
+var debugErrorTemplate = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>errors - {{.Entry.ID}}</title>
+` + debugStyle + `
+</head>
+<body>
+<h1><a href="{{.Prefix}}">errors</a> - {{.Entry.ID}}</h1>
+<p><a href="{{.Prefix}}errors/{{.Entry.ID}}.json">json</a></p>
+<table>
+<tr><th>ID</th><td>{{.Entry.ID}}</td></tr>
+<tr><th>Timestamp</th><td>{{.Entry.Timestamp.Format "2006-01-02T15:04:05.000Z07:00"}}</td></tr>
+<tr><th>Class</th><td><a href="{{.Prefix}}?class={{.Entry.Key}}">{{.Entry.Key}}</a> {{.Entry.Name}}</td></tr>
+<tr><th>Message</th><td>{{.Entry.Message}}</td></tr>
+<tr><th>Details</th><td>{{.Entry.Details}}</td></tr>
+<tr><th>Operation</th><td>{{.Entry.Operation}}</td></tr>
+{{- range $key, $value := .Entry.Fields}}
+<tr><th>{{$key}}</th><td>{{$value}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
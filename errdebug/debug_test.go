@@ -0,0 +1,154 @@
+package errdebug
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestHandler tests the class registry and recent errors debug handler.
+func TestHandler(t *testing.T) {
+	errors.ResetCounters()
+
+	errors.EnableCounters()
+	defer errors.DisableCounters()
+
+	mjr := errors.MustNewMajor()
+	mnr := errors.MustNewMinor(mjr)
+	clFirst := errors.MustNewClassWIndex(mjr, mnr)
+	clSecond := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+	errors.SetClassName(errors.MustNewMajorClass(mjr), "repository")
+	errors.SetClassOwner(errors.MustNewMajorClass(mjr), "storage-team")
+	errors.SetClassName(clFirst, "<invalid filter>")
+	errors.SetClassPublicMessage(clFirst, "invalid filter")
+	errors.SetClassSeverity(clSecond, errors.SeverityCritical)
+
+	h := Handler("/debug/errors")
+
+	get := func(t *testing.T, path string) (*http.Response, string) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		resp := rec.Result()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		resp, body := get(t, "/debug/errors/")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, body, "The errors journal is not enabled.")
+
+		resp, _ = get(t, "/debug/errors/errors.json")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	j := errors.EnableJournal(10)
+	defer errors.DisableJournal()
+
+	first := errors.Build(clFirst).Msg("first").Field("table", "users").Err()
+	second := errors.NewDet(clSecond, "second")
+	third := errors.NewDet(clFirst, "third")
+
+	t.Run("Registry", func(t *testing.T) {
+		resp, body := get(t, "/debug/errors/registry.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var classes []debugClass
+		require.NoError(t, json.Unmarshal([]byte(body), &classes))
+
+		byClass := map[errors.Class]debugClass{}
+		for _, c := range classes {
+			byClass[c.Class] = c
+		}
+		assert.Contains(t, byClass, errors.ClInvalidCatalog)
+		assert.Contains(t, byClass, errors.MustNewMinorClass(mjr, mnr))
+
+		c, ok := byClass[clFirst]
+		require.True(t, ok)
+		assert.Equal(t, clFirst.Key(), c.Key)
+		assert.Equal(t, mjr, c.Major)
+		assert.Equal(t, mnr, c.Minor)
+		assert.Equal(t, clFirst.Index(), c.Index)
+		assert.Equal(t, "<invalid filter>", c.Name)
+		assert.Equal(t, "storage-team", c.Owner)
+		assert.Equal(t, "invalid filter", c.PublicMessage)
+		assert.Equal(t, uint64(2), c.Count)
+
+		assert.Equal(t, "critical", byClass[clSecond].Severity)
+		assert.Equal(t, uint64(1), byClass[clSecond].Count)
+	})
+
+	t.Run("Index", func(t *testing.T) {
+		resp, body := get(t, "/debug/errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Contains(t, body, "&lt;invalid filter&gt;")
+		assert.Contains(t, body, "storage-team")
+		assert.Contains(t, body, "/debug/errors/errors/"+second.ID().String())
+
+		_, body = get(t, "/debug/errors/?class="+clSecond.Key())
+		assert.Contains(t, body, second.ID().String())
+		assert.NotContains(t, body, first.ID().String())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		resp, body := get(t, "/debug/errors/errors.json?class="+errors.MustNewMajorClass(mjr).Key()+"&limit=2")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var entries []debugEntry
+		require.NoError(t, json.Unmarshal([]byte(body), &entries))
+		require.Len(t, entries, 2)
+		assert.Equal(t, third.ID(), entries[0].ID)
+		assert.Equal(t, second.ID(), entries[1].ID)
+		assert.Equal(t, clSecond.Key(), entries[1].Key)
+
+		resp, _ = get(t, "/debug/errors/errors.json?class=1.2")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = get(t, "/debug/errors/errors.json?limit=-1")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 3, j.Len(), "invalid requests should not record errors")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		resp, body := get(t, "/debug/errors/errors/"+first.ID().String()+".json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var entry debugEntry
+		require.NoError(t, json.Unmarshal([]byte(body), &entry))
+		assert.Equal(t, first.ID(), entry.ID)
+		assert.Equal(t, "first", entry.Message)
+		assert.Equal(t, "<invalid filter>", entry.Name)
+		assert.Equal(t, "users", entry.Fields["table"])
+
+		resp, body = get(t, "/debug/errors/errors/"+first.ID().String())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, body, "users")
+		assert.Contains(t, body, first.Operation())
+
+		resp, _ = get(t, "/debug/errors/errors/invalid-id")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		errors.DisableJournal()
+		resp, _ = get(t, "/debug/errors/errors/"+first.ID().String())
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		resp, _ := get(t, "/debug/errors/unknown")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, _ = get(t, "/other")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
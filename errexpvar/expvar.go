@@ -0,0 +1,57 @@
+// Package errexpvar publishes the error counters of the github.com/neuronlabs/errors package
+// with the expvar package.
+//
+// The package is typically imported only for the side effect of publishing the counters:
+//
+//	import _ "github.com/neuronlabs/errors/errexpvar"
+//
+// The counters are published under the Name with the 'classes' counts and the 'majors' rollups
+// keyed by the class keys and the 'names' of these classes. The errors are counted only after
+// calling the errors.EnableCounters function.
+package errexpvar
+
+import (
+	"expvar"
+
+	"github.com/neuronlabs/errors"
+)
+
+// Name is the name under which the error counters are published.
+const Name = "github.com/neuronlabs/errors"
+
+func init() {
+	expvar.Publish(Name, expvar.Func(func() interface{} {
+		return Snapshot()
+	}))
+}
+
+// Counters are the published error counters.
+// The classes and majors are keyed by the dot separated major, minor and index of their classes i.e. '1.2.3'.
+type Counters struct {
+	// Classes are the error counts of each class.
+	Classes map[string]uint64 `json:"classes"`
+	// Majors are the error counts rolled up to the major classes.
+	Majors map[string]uint64 `json:"majors"`
+	// Names are the names of the counted classes and majors which have the name defined.
+	Names map[string]string `json:"names"`
+}
+
+// Snapshot gets the current error counters.
+func Snapshot() Counters {
+	counters := Counters{
+		Classes: map[string]uint64{},
+		Majors:  map[string]uint64{},
+		Names:   map[string]string{},
+	}
+	for class, count := range errors.ClassCounts() {
+		major := class.MajorClass()
+		counters.Classes[class.Key()] += count
+		counters.Majors[major.Key()] += count
+		for _, c := range []errors.Class{class, major} {
+			if name := errors.ClassName(c); name != "" {
+				counters.Names[c.Key()] = name
+			}
+		}
+	}
+	return counters
+}
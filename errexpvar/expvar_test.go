@@ -0,0 +1,54 @@
+package errexpvar
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestPublish tests the error counters published with the expvar package.
+func TestPublish(t *testing.T) {
+	errors.ResetCounters()
+	errors.EnableCounters()
+	defer errors.DisableCounters()
+
+	mjr := errors.MustNewMajor()
+	clFirst := errors.MustNewClassWIndex(mjr, errors.MustNewMinor(mjr))
+	clSecond := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+	errors.SetClassName(errors.MustNewMajorClass(mjr), "repository")
+
+	other := errors.MustNewMajor()
+	clOther := errors.MustNewMajorClass(other)
+
+	// the classes sharing the name, or named as the key of another class, are not merged.
+	errors.SetClassName(clFirst, "shared")
+	errors.SetClassName(clSecond, "shared")
+	errors.SetClassName(clOther, clFirst.Key())
+
+	for i := 0; i < 3; i++ {
+		errors.New(clFirst, "first")
+	}
+	errors.NewDet(clSecond, "second")
+	errors.New(clOther, "other")
+
+	v := expvar.Get(Name)
+	require.NotNil(t, v)
+
+	published := Counters{}
+	require.NoError(t, json.Unmarshal([]byte(v.String()), &published))
+	assert.Equal(t, Counters{
+		Classes: map[string]uint64{clFirst.Key(): 3, clSecond.Key(): 1, clOther.Key(): 1},
+		Majors:  map[string]uint64{errors.MustNewMajorClass(mjr).Key(): 4, clOther.Key(): 1},
+		Names: map[string]string{
+			clFirst.Key():                       "shared",
+			clSecond.Key():                      "shared",
+			errors.MustNewMajorClass(mjr).Key(): "repository",
+			clOther.Key():                       clFirst.Key(),
+		},
+	}, published)
+}
@@ -0,0 +1,76 @@
+// Package errmetrics exposes the error counters of the github.com/neuronlabs/errors package
+// in the Prometheus text exposition format.
+//
+// The handler is typically registered on the metrics endpoint of the service:
+//
+//	errors.EnableCounters()
+//	http.Handle("/metrics", errmetrics.Handler())
+package errmetrics
+
+import (
+	"bufio"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/neuronlabs/errors"
+)
+
+// Name is the name of the error counters metric exposed by the Handler.
+const Name = "errors_total"
+
+// Handler gets the http.Handler that exposes the error counters in the Prometheus text
+// exposition format. Each class is exposed with the 'major', 'minor', 'index', 'name', 'major_name',
+// 'minor_name' and 'severity' labels. The counters needs to be enabled with the errors.EnableCounters function.
+func Handler() http.Handler {
+	return http.HandlerFunc(serveMetrics)
+}
+
+func serveMetrics(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w := bufio.NewWriter(rw)
+	writeMetrics(w)
+	w.Flush()
+}
+
+func writeMetrics(w *bufio.Writer) {
+	counts := errors.ClassCounts()
+	classes := make([]errors.Class, 0, len(counts))
+	for class := range counts {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+
+	w.WriteString("# HELP " + Name + " Number of the created errors by class.\n")
+	w.WriteString("# TYPE " + Name + " counter\n")
+	for _, class := range classes {
+		w.WriteString(Name)
+		w.WriteString("{major=\"")
+		w.WriteString(strconv.Itoa(int(class.Major())))
+		w.WriteString("\",minor=\"")
+		w.WriteString(strconv.Itoa(int(class.Minor())))
+		w.WriteString("\",index=\"")
+		w.WriteString(strconv.Itoa(int(class.Index())))
+		w.WriteString("\",name=\"")
+		w.WriteString(escapeLabelValue(errors.ClassName(class)))
+		w.WriteString("\",major_name=\"")
+		w.WriteString(escapeLabelValue(errors.ClassName(class.MajorClass())))
+		w.WriteString("\",minor_name=\"")
+		if class.Minor() != 0 {
+			w.WriteString(escapeLabelValue(errors.ClassName(class.MinorClass())))
+		}
+		w.WriteString("\",severity=\"")
+		w.WriteString(errors.ClassSeverity(class).String())
+		w.WriteString("\"} ")
+		w.WriteString(strconv.FormatUint(counts[class], 10))
+		w.WriteByte('\n')
+	}
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes the label value according to the Prometheus text exposition format.
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
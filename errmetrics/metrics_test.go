@@ -0,0 +1,57 @@
+package errmetrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestHandler tests the Prometheus format metrics exposition.
+func TestHandler(t *testing.T) {
+	errors.ResetCounters()
+
+	errors.EnableCounters()
+	defer errors.DisableCounters()
+
+	mjr := errors.MustNewMajor()
+	mnr := errors.MustNewMinor(mjr)
+	clFirst := errors.MustNewClassWIndex(mjr, mnr)
+	clSecond := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+	errors.SetClassName(errors.MustNewMajorClass(mjr), "repository")
+	errors.SetClassName(errors.MustNewMinorClass(mjr, mnr), "query")
+	errors.SetClassName(clFirst, `invalid "filter"`)
+	errors.SetClassSeverity(clSecond, errors.SeverityCritical)
+
+	other := errors.MustNewMajor()
+	clOther := errors.MustNewMajorClass(other)
+
+	errors.New(clFirst, "first")
+	errors.New(clFirst, "first")
+	errors.NewDet(clSecond, "second")
+	errors.New(clOther, "other")
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	resp := rec.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`# HELP errors_total Number of the created errors by class.
+# TYPE errors_total counter
+errors_total{major="%[1]d",minor="%[2]d",index="%[3]d",name="invalid \"filter\"",major_name="repository",minor_name="query",severity="error"} 2
+errors_total{major="%[1]d",minor="%[4]d",index="0",name="",major_name="repository",minor_name="",severity="critical"} 1
+errors_total{major="%[5]d",minor="0",index="0",name="",major_name="",minor_name="",severity="error"} 1
+`, mjr, mnr, clFirst.Index(), clSecond.Minor(), other)
+	assert.Equal(t, expected, string(body))
+}
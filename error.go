@@ -1,6 +1,7 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 )
 
@@ -13,12 +14,16 @@ type simpleError struct {
 
 // New creates simple ClassError for provided 'c' Class and 'msg' message.
 func New(c Class, msg string) ClassError {
-	return &simpleError{c, msg}
+	err := &simpleError{c, msg}
+	notifyCreated(err)
+	return err
 }
 
 // Newf creates simple formatted ClassError for provided 'c' Class, 'format' and arguments 'args'.
 func Newf(c Class, format string, args ...interface{}) ClassError {
-	return &simpleError{c, fmt.Sprintf(format, args...)}
+	err := &simpleError{c, fmt.Sprintf(format, args...)}
+	notifyCreated(err)
+	return err
 }
 
 // Error implements error interface.
@@ -30,3 +35,12 @@ func (s *simpleError) Error() string {
 func (s *simpleError) Class() Class {
 	return s.class
 }
+
+// AsClassError gets the first ClassError found in the 'err' chain.
+func AsClassError(err error) (ClassError, bool) {
+	var classError ClassError
+	if err == nil || !stderrors.As(err, &classError) {
+		return nil, false
+	}
+	return classError, true
+}
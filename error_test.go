@@ -22,9 +22,9 @@ func TestSimpleError(t *testing.T) {
 	assert.Equal(t, ClInvalidMinor, simple2.Class())
 	assert.Equal(t, "two: 2", simple2.Error())
 
-	multi := MultiError([]ClassError{simple1, simple2})
+	multi := MultiError{simple1, simple2}
 
-	assert.Equal(t, "testing message,two: 2", multi.Error())
+	assert.Equal(t, "testing message, two: 2", multi.Error())
 	assert.True(t, multi.HasMajor(ClInvalidMajor.Major()))
 
 	var invalidMajor Major
@@ -0,0 +1,66 @@
+package errors
+
+import (
+	"context"
+	"sync"
+)
+
+// CancelPolicy defines when the Group cancels the context of its workers.
+type CancelPolicy int
+
+// Enumerated cancel policies.
+const (
+	// CancelOnFirst cancels the group context on the first worker error.
+	CancelOnFirst CancelPolicy = iota
+	// CancelOnClass cancels the group context on the first worker error of the group classes.
+	CancelOnClass
+	// CancelNever never cancels the group context until all workers are done.
+	CancelNever
+)
+
+// Group is the collection of goroutines working on subtasks of the same task.
+// The context of the workers is cancelled according to the group CancelPolicy.
+// All the errors returned by the workers are collected into a MultiError.
+type Group struct {
+	wg        sync.WaitGroup
+	cancel    context.CancelFunc
+	collector *Collector
+}
+
+// NewGroup creates new Group with the context derived from 'ctx' that is cancelled
+// according to provided 'policy'. The 'classes' are used by the CancelOnClass policy and are matched
+// the same way as in the MultiError Contains method.
+// The derived context is also cancelled when the Wait method returns.
+func NewGroup(ctx context.Context, policy CancelPolicy, classes ...Class) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	g := &Group{cancel: cancel, collector: NewCollector(0)}
+
+	switch policy {
+	case CancelOnFirst:
+		g.collector.CancelOn(cancel)
+	case CancelOnClass:
+		if len(classes) > 0 {
+			g.collector.CancelOn(cancel, classes...)
+		}
+	}
+	return g, ctx
+}
+
+// Go calls the function 'f' in a new goroutine.
+// The error returned by the 'f' is collected by the group.
+func (g *Group) Go(f func() error) {
+	g.wg.Add(1)
+
+	go func() {
+		defer g.wg.Done()
+		g.collector.Add(f())
+	}()
+}
+
+// Wait blocks until all the workers are done. Returns the MultiError
+// with the errors returned by the workers or nil if none of them failed.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	return g.collector.Err()
+}
@@ -0,0 +1,102 @@
+package errors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGroup tests the errors Group cancel policies.
+func TestGroup(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	clFatal := MustNewMinorClass(mjr, MustNewMinor(mjr))
+	clMinor := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	// waitCancel waits for the context cancellation and returns true if the context was cancelled.
+	waitCancel := func(ctx context.Context, timeout time.Duration) bool {
+		select {
+		case <-ctx.Done():
+			return true
+		case <-time.After(timeout):
+			return false
+		}
+	}
+
+	t.Run("CancelOnFirst", func(t *testing.T) {
+		g, ctx := NewGroup(context.Background(), CancelOnFirst)
+
+		var cancelled bool
+		g.Go(func() error { return New(clMinor, "minor") })
+		g.Go(func() error {
+			cancelled = waitCancel(ctx, time.Second)
+			return nil
+		})
+
+		err := g.Wait()
+		require.Error(t, err)
+		assert.True(t, cancelled)
+		assert.Equal(t, []Class{clMinor}, err.(MultiError).Classes())
+	})
+
+	t.Run("CancelOnClass", func(t *testing.T) {
+		g, ctx := NewGroup(context.Background(), CancelOnClass, clFatal)
+
+		minorDone := make(chan struct{})
+		var cancelled bool
+		g.Go(func() error {
+			defer close(minorDone)
+			return New(clMinor, "minor")
+		})
+		g.Go(func() error {
+			<-minorDone
+			if waitCancel(ctx, 20*time.Millisecond) {
+				return New(clMinor, "cancelled too early")
+			}
+			return NewDet(clFatal, "fatal")
+		})
+		g.Go(func() error {
+			<-minorDone
+			cancelled = waitCancel(ctx, time.Second)
+			return nil
+		})
+
+		err := g.Wait()
+		require.Error(t, err)
+		assert.True(t, cancelled)
+
+		m := err.(MultiError)
+		assert.Len(t, m, 2)
+		assert.Equal(t, []Class{clMinor, clFatal}, m.Classes())
+		_, ok := m.First(clFatal).(DetailedError)
+		assert.True(t, ok)
+	})
+
+	t.Run("CancelNever", func(t *testing.T) {
+		g, ctx := NewGroup(context.Background(), CancelNever)
+
+		var cancelled bool
+		g.Go(func() error { return New(clFatal, "fatal") })
+		g.Go(func() error { return New(clMinor, "minor") })
+		g.Go(func() error {
+			cancelled = waitCancel(ctx, 50*time.Millisecond)
+			return nil
+		})
+
+		err := g.Wait()
+		require.Error(t, err)
+		assert.False(t, cancelled)
+		assert.Len(t, err.(MultiError), 2)
+		assert.Error(t, ctx.Err())
+	})
+
+	t.Run("NoErrors", func(t *testing.T) {
+		g, _ := NewGroup(context.Background(), CancelOnFirst)
+		g.Go(func() error { return nil })
+		assert.NoError(t, g.Wait())
+	})
+}
@@ -0,0 +1,200 @@
+package errors
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// EventKind defines the kind of the error event.
+type EventKind int
+
+// Enumerated error event kinds.
+const (
+	// EventCreated is the event of a new error creation.
+	EventCreated EventKind = iota
+	// EventWrapped is the event of a new error creation that wraps its cause.
+	EventWrapped
+)
+
+// String implements fmt.Stringer interface.
+func (k EventKind) String() string {
+	switch k {
+	case EventCreated:
+		return "created"
+	case EventWrapped:
+		return "wrapped"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is the error event passed to the hooks.
+type Event struct {
+	Kind EventKind
+	Err  ClassError
+}
+
+// HookFunc is the function called on the error events.
+// A hook should not create errors of the classes it is registered for.
+type HookFunc func(e Event)
+
+// OnHookPanic is called with the recovered value when a hook panics.
+// If nil, the panics are silently recovered.
+var OnHookPanic func(recovered interface{})
+
+// hooks is the copy on write list of registered hooks.
+var hooks = &hookRegistry{}
+
+type hookRegistry struct {
+	sync.Mutex
+	list atomic.Value
+}
+
+type hook struct {
+	patterns []Class
+	fn       HookFunc
+	dropped  uint64
+
+	// queueLock guards the asynchronous hook queue from being closed while sending the events.
+	queueLock sync.RWMutex
+	queue     chan Event
+	closed    bool
+}
+
+// HookHandle is the handle of the registered hook.
+type HookHandle struct {
+	hook *hook
+	once sync.Once
+	done chan struct{}
+}
+
+// RegisterHook registers the 'fn' hook called synchronously whenever an error
+// of the 'patterns' classes is created or wrapped. The patterns are matched the same way
+// as in the MultiError Contains method. If no patterns are provided the hook is called for all errors.
+func RegisterHook(fn HookFunc, patterns ...Class) *HookHandle {
+	h := &HookHandle{hook: &hook{patterns: patterns, fn: fn}}
+	hooks.add(h.hook)
+	return h
+}
+
+// RegisterAsyncHook registers the 'fn' hook called asynchronously whenever an error
+// of the 'patterns' classes is created or wrapped. The events are queued in a queue of
+// provided 'size' and consumed by a single goroutine. If the queue is full the events are dropped.
+func RegisterAsyncHook(size int, fn HookFunc, patterns ...Class) *HookHandle {
+	h := &HookHandle{
+		hook: &hook{patterns: patterns, fn: fn, queue: make(chan Event, size)},
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(h.done)
+		for e := range h.hook.queue {
+			h.hook.call(e)
+		}
+	}()
+	hooks.add(h.hook)
+	return h
+}
+
+// Unregister unregisters the hook. For the asynchronous hooks it waits
+// until all the queued events are handled.
+func (h *HookHandle) Unregister() {
+	h.once.Do(func() {
+		hooks.remove(h.hook)
+		if h.hook.queue != nil {
+			h.hook.queueLock.Lock()
+			h.hook.closed = true
+			close(h.hook.queue)
+			h.hook.queueLock.Unlock()
+			<-h.done
+		}
+	})
+}
+
+// Dropped gets the number of the events dropped because the asynchronous hook queue was full.
+func (h *HookHandle) Dropped() uint64 {
+	return atomic.LoadUint64(&h.hook.dropped)
+}
+
+// notifyCreated is called whenever a classified error is created, also
+// when it is built with a cause that it wraps.
+func notifyCreated(err ClassError) {
+	counters.inc(err.Class())
+
+	list, _ := hooks.list.Load().([]*hook)
+	if len(list) == 0 {
+		return
+	}
+	e := Event{Kind: EventCreated, Err: err}
+	if u, ok := err.(interface{ Unwrap() error }); ok && u.Unwrap() != nil {
+		e.Kind = EventWrapped
+	}
+	for _, h := range list {
+		if !h.matches(err.Class()) {
+			continue
+		}
+		if h.queue == nil {
+			h.call(e)
+			continue
+		}
+		h.enqueue(e)
+	}
+}
+
+func (r *hookRegistry) add(h *hook) {
+	r.Lock()
+	defer r.Unlock()
+
+	list, _ := r.list.Load().([]*hook)
+	updated := make([]*hook, len(list), len(list)+1)
+	copy(updated, list)
+	r.list.Store(append(updated, h))
+}
+
+func (r *hookRegistry) remove(h *hook) {
+	r.Lock()
+	defer r.Unlock()
+
+	list, _ := r.list.Load().([]*hook)
+	updated := make([]*hook, 0, len(list))
+	for _, registered := range list {
+		if registered != h {
+			updated = append(updated, registered)
+		}
+	}
+	r.list.Store(updated)
+}
+
+func (h *hook) matches(class Class) bool {
+	if len(h.patterns) == 0 {
+		return true
+	}
+	for _, pattern := range h.patterns {
+		if pattern.contains(class) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *hook) enqueue(e Event) {
+	h.queueLock.RLock()
+	defer h.queueLock.RUnlock()
+
+	if h.closed {
+		return
+	}
+	select {
+	case h.queue <- e:
+	default:
+		atomic.AddUint64(&h.dropped, 1)
+	}
+}
+
+func (h *hook) call(e Event) {
+	defer func() {
+		if r := recover(); r != nil && OnHookPanic != nil {
+			OnHookPanic(r)
+		}
+	}()
+	h.fn(e)
+}
@@ -0,0 +1,114 @@
+package errors
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHooks tests the error creation hooks.
+func TestHooks(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	mnr := MustNewMinor(mjr)
+	clFirst := MustNewClassWIndex(mjr, mnr)
+	clSecond := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	t.Run("Sync", func(t *testing.T) {
+		var events []Event
+		h := RegisterHook(func(e Event) { events = append(events, e) }, MustNewMinorClass(mjr, mnr))
+
+		first := New(clFirst, "first")
+		New(clSecond, "second")
+		wrapped := Build(clFirst).Cause(io.EOF).Err()
+		h.Unregister()
+		New(clFirst, "not handled")
+
+		require.Len(t, events, 2)
+		assert.Equal(t, Event{Kind: EventCreated, Err: first}, events[0])
+		assert.Equal(t, Event{Kind: EventWrapped, Err: wrapped}, events[1])
+		assert.Equal(t, "wrapped", EventWrapped.String())
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		var recovered interface{}
+		OnHookPanic = func(r interface{}) { recovered = r }
+		defer func() { OnHookPanic = nil }()
+
+		var called bool
+		panicking := RegisterHook(func(Event) { panic("hook panic") })
+		defer panicking.Unregister()
+		other := RegisterHook(func(Event) { called = true })
+		defer other.Unregister()
+
+		assert.NotPanics(t, func() { New(clSecond, "second") })
+		assert.Equal(t, "hook panic", recovered)
+		assert.True(t, called)
+	})
+
+	t.Run("Async", func(t *testing.T) {
+		mu := &sync.Mutex{}
+		var events []Event
+		block := make(chan struct{})
+		h := RegisterAsyncHook(2, func(e Event) {
+			<-block
+			mu.Lock()
+			events = append(events, e)
+			mu.Unlock()
+		}, clSecond)
+
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				NewDet(clSecond, "second")
+				New(clFirst, "first")
+			}()
+		}
+		wg.Wait()
+		close(block)
+		h.Unregister()
+		h.Unregister()
+
+		mu.Lock()
+		defer mu.Unlock()
+		// the worker might have taken a single event before the queue got full.
+		assert.True(t, len(events) == 2 || len(events) == 3, len(events))
+		assert.Equal(t, uint64(10-len(events)), h.Dropped())
+		for _, e := range events {
+			assert.Equal(t, clSecond, e.Err.Class())
+		}
+	})
+}
+
+// TestHooksRegistryAccess tests that the hooks might read the class registry
+// while being notified about the errors created by the registry.
+func TestHooksRegistryAccess(t *testing.T) {
+	resetContainer()
+
+	var names []string
+	h := RegisterHook(func(e Event) { names = append(names, ClassName(e.Err.Class())) })
+	defer h.Unregister()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := NewMinor(0)
+		assert.Error(t, err)
+		_, err = NewIndex(0, 0)
+		assert.Error(t, err)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("creating the registry errors deadlocked with the hook")
+	}
+	assert.Len(t, names, 2)
+}
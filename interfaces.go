@@ -1,6 +1,8 @@
 package errors
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -28,8 +30,24 @@ type Detailer interface {
 	WrapDetailsf(format string, args ...interface{})
 }
 
+// DetailEntrier is the interface used for the errors that keep their details as separate entries.
+type DetailEntrier interface {
+	// DetailEntries gets the details entries in order of their occurrence in the Details.
+	DetailEntries() []Detail
+}
+
+// Detail is a single entry of the error details.
+type Detail struct {
+	// Text is the human readable detail.
+	Text string `json:"text"`
+	// Operation is the runtime operation that added the detail.
+	Operation string `json:"operation,omitempty"`
+}
+
 // DetailedError is the error that implements
 // ClassError, Detailer, Indexer, Operationer interfaces.
+// The detailed errors created by this package implements also
+// DetailEntrier, Fielder, PublicMessager, Severitier and Timestamper interfaces.
 type DetailedError interface {
 	ClassError
 	Indexer
@@ -37,6 +55,14 @@ type DetailedError interface {
 	Operationer
 }
 
+// Fielder is the interface used for setting and getting the error key-value fields.
+type Fielder interface {
+	// Fields gets the error key-value fields.
+	Fields() map[string]interface{}
+	// SetField sets the field with the 'key' and 'value'.
+	SetField(key string, value interface{})
+}
+
 // Indexer is the an enhanced error interface.
 type Indexer interface {
 	// ID gets a unique error instance identification number.
@@ -52,3 +78,26 @@ type Operationer interface {
 	// AppendOperation wraps the operation creating a chain of operations.
 	AppendOperation(operation string)
 }
+
+// PublicMessager is the interface used for the errors that separates
+// the internal message from the one that is safe to be shown to the external consumers.
+type PublicMessager interface {
+	// PublicMessage gets the message safe to be shown to the external consumers.
+	PublicMessage() string
+	// SetPublicMessage sets the public message.
+	SetPublicMessage(message string)
+}
+
+// Severitier is the interface used for the errors with instance specific severity.
+type Severitier interface {
+	// Severity gets the error severity.
+	Severity() Severity
+	// SetSeverity sets the error instance severity.
+	SetSeverity(s Severity)
+}
+
+// Timestamper is the interface used for the errors that records the time of their creation.
+type Timestamper interface {
+	// Timestamp gets the time when the error was created.
+	Timestamp() time.Time
+}
@@ -0,0 +1,308 @@
+package errors
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// compile time check for the Journal interfaces.
+var (
+	_ io.WriterTo   = &Journal{}
+	_ io.ReaderFrom = &Journal{}
+)
+
+var journal = struct {
+	sync.Mutex
+	journal *Journal
+	hook    *HookHandle
+}{}
+
+// EnableJournal enables recording all created detailed errors in a new Journal of provided 'size'.
+// If the journal was already enabled, the previous one is replaced.
+func EnableJournal(size int) *Journal {
+	j := NewJournal(size)
+
+	journal.Lock()
+	defer journal.Unlock()
+
+	if journal.hook != nil {
+		journal.hook.Unregister()
+	}
+	journal.journal = j
+	journal.hook = RegisterHook(func(e Event) {
+		if detailed, ok := e.Err.(DetailedError); ok {
+			j.Record(detailed)
+		}
+	})
+	return j
+}
+
+// DisableJournal disables recording the detailed errors.
+func DisableJournal() {
+	journal.Lock()
+	defer journal.Unlock()
+
+	if journal.hook != nil {
+		journal.hook.Unregister()
+	}
+	journal.hook = nil
+	journal.journal = nil
+}
+
+// EnabledJournal gets the journal enabled by the EnableJournal function. Returns nil if the journal is not enabled.
+func EnabledJournal() *Journal {
+	journal.Lock()
+	defer journal.Unlock()
+
+	return journal.journal
+}
+
+// JournalEntry is the recorded detailed error.
+type JournalEntry struct {
+	ID        uuid.UUID              `json:"id"`
+	Timestamp time.Time              `json:"timestamp"`
+	Class     Class                  `json:"class"`
+	Message   string                 `json:"message"`
+	Details   string                 `json:"details,omitempty"`
+	Operation string                 `json:"operation,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// JournalQuery is the query for the journal entries.
+type JournalQuery struct {
+	// Class filters the entries of given class. The classes are matched the same way
+	// as in the MultiError Contains method. Zero value matches all classes.
+	Class Class
+	// From filters the entries created at or after given time. Zero value means no lower bound.
+	From time.Time
+	// To filters the entries created before given time. Zero value means no upper bound.
+	To time.Time
+	// Limit is the maximum number of the newest entries returned. Zero means no limit.
+	Limit int
+}
+
+// Journal is the bounded ring buffer of the recorded detailed errors.
+// When the journal is full the oldest entries are overwritten.
+// The entries of the recorded errors reflects the current state of the error,
+// so that the details and fields set after the error creation are also available.
+type Journal struct {
+	mu      sync.RWMutex
+	records []journalRecord
+	next    int
+	full    bool
+	index   map[uuid.UUID]int
+}
+
+type journalRecord struct {
+	err   DetailedError
+	entry JournalEntry
+}
+
+// NewJournal creates new Journal with provided 'size'.
+// If the 'size' is lower than one, the journal is of size one.
+func NewJournal(size int) *Journal {
+	if size < 1 {
+		size = 1
+	}
+	return &Journal{records: make([]journalRecord, size), index: map[uuid.UUID]int{}}
+}
+
+// Record records the 'err' detailed error in the journal.
+func (j *Journal) Record(err DetailedError) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.add(journalRecord{err: err})
+}
+
+// Len gets the number of entries in the journal.
+func (j *Journal) Len() int {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	if j.full {
+		return len(j.records)
+	}
+	return j.next
+}
+
+// Lookup gets the journal entry of the error with provided 'id'.
+func (j *Journal) Lookup(id uuid.UUID) (JournalEntry, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	i, ok := j.index[id]
+	if !ok {
+		return JournalEntry{}, false
+	}
+	return j.records[i].journalEntry(), true
+}
+
+// Entries gets all the journal entries from the oldest to the newest.
+func (j *Journal) Entries() []JournalEntry {
+	return j.Query(JournalQuery{})
+}
+
+// Query gets the journal entries matching the 'q' query from the oldest to the newest.
+func (j *Journal) Query(q JournalQuery) []JournalEntry {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	var entries []JournalEntry
+	j.each(func(r journalRecord) {
+		entry := r.journalEntry()
+		if q.Class != 0 && !q.Class.contains(entry.Class) {
+			return
+		}
+		if !q.From.IsZero() && entry.Timestamp.Before(q.From) {
+			return
+		}
+		if !q.To.IsZero() && !entry.Timestamp.Before(q.To) {
+			return
+		}
+		entries = append(entries, entry)
+	})
+	if q.Limit > 0 && len(entries) > q.Limit {
+		entries = entries[len(entries)-q.Limit:]
+	}
+	return entries
+}
+
+// WriteTo implements io.WriterTo interface. It writes the journal entries
+// from the oldest to the newest as JSON lines.
+func (j *Journal) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	enc := json.NewEncoder(cw)
+	for _, entry := range j.Entries() {
+		if err := enc.Encode(entry); err != nil {
+			return cw.n, err
+		}
+	}
+	return cw.n, nil
+}
+
+// ReadFrom implements io.ReaderFrom interface. It reads the JSON lines
+// journal entries and records them in the journal.
+func (j *Journal) ReadFrom(r io.Reader) (int64, error) {
+	cr := &countingReader{r: bufio.NewReader(r)}
+	dec := json.NewDecoder(cr)
+	for {
+		entry := JournalEntry{}
+		if err := dec.Decode(&entry); err == io.EOF {
+			return cr.n, nil
+		} else if err != nil {
+			return cr.n, err
+		}
+		j.mu.Lock()
+		j.add(journalRecord{entry: entry})
+		j.mu.Unlock()
+	}
+}
+
+// SaveFile persists the journal entries in the JSON lines file at provided 'path'.
+func (j *Journal) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err = j.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadFile loads the journal entries from the JSON lines file at provided 'path'.
+func (j *Journal) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = j.ReadFrom(f)
+	return err
+}
+
+func (j *Journal) add(r journalRecord) {
+	if j.full {
+		// the overwritten record id might be indexed to its newer copy.
+		if id := j.records[j.next].id(); j.index[id] == j.next {
+			delete(j.index, id)
+		}
+	}
+	j.records[j.next] = r
+	j.index[r.id()] = j.next
+	j.next++
+	if j.next == len(j.records) {
+		j.next = 0
+		j.full = true
+	}
+}
+
+// each calls the 'f' function for each record from the oldest to the newest.
+func (j *Journal) each(f func(r journalRecord)) {
+	if j.full {
+		for _, r := range j.records[j.next:] {
+			f(r)
+		}
+	}
+	for _, r := range j.records[:j.next] {
+		f(r)
+	}
+}
+
+func (r journalRecord) id() uuid.UUID {
+	if r.err != nil {
+		return r.err.ID()
+	}
+	return r.entry.ID
+}
+
+func (r journalRecord) journalEntry() JournalEntry {
+	if r.err == nil {
+		return r.entry
+	}
+	entry := JournalEntry{
+		ID:        r.err.ID(),
+		Class:     r.err.Class(),
+		Message:   r.err.Error(),
+		Details:   r.err.Details(),
+		Operation: r.err.Operation(),
+	}
+	if e, ok := r.err.(Timestamper); ok {
+		entry.Timestamp = e.Timestamp()
+	}
+	if e, ok := r.err.(Fielder); ok {
+		entry.Fields = e.Fields()
+	}
+	return entry
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
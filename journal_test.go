@@ -0,0 +1,117 @@
+package errors
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJournal tests the detailed errors journal.
+func TestJournal(t *testing.T) {
+	resetContainer()
+
+	ts := time.Date(2019, 9, 1, 12, 0, 0, 0, time.UTC)
+	clock = func() time.Time {
+		ts = ts.Add(time.Minute)
+		return ts
+	}
+	defer func() { clock = time.Now }()
+
+	mjr := MustNewMajor()
+	mnr := MustNewMinor(mjr)
+	clFirst := MustNewClassWIndex(mjr, mnr)
+	clSecond := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	j := EnableJournal(3)
+	defer DisableJournal()
+	assert.Equal(t, j, EnabledJournal())
+
+	oldest := NewDet(clFirst, "oldest")
+	New(clFirst, "simple errors are not recorded")
+	first := NewDet(clFirst, "first").(*detailedError)
+	second := NewDetf(clSecond, "second: %d", 2).(*detailedError)
+	third := Build(clFirst).Msg("third").Err().(*detailedError)
+
+	// details set after creation should be visible.
+	first.SetDetails("First details.")
+	first.SetField("model", "user")
+
+	assert.Equal(t, 3, j.Len())
+	_, ok := j.Lookup(oldest.ID())
+	assert.False(t, ok, "the oldest entry should be overwritten")
+
+	entry, ok := j.Lookup(first.ID())
+	require.True(t, ok)
+	assert.Equal(t, JournalEntry{
+		ID:        first.ID(),
+		Timestamp: first.Timestamp(),
+		Class:     clFirst,
+		Message:   "first",
+		Details:   "First details.",
+		Operation: first.Operation(),
+		Fields:    map[string]interface{}{"model": "user"},
+	}, entry)
+
+	ids := func(entries []JournalEntry) []uuid.UUID {
+		var result []uuid.UUID
+		for _, e := range entries {
+			result = append(result, e.ID)
+		}
+		return result
+	}
+	assert.Equal(t, []uuid.UUID{first.ID(), second.ID(), third.ID()}, ids(j.Entries()))
+	assert.Equal(t, []uuid.UUID{first.ID(), third.ID()}, ids(j.Query(JournalQuery{Class: MustNewMinorClass(mjr, mnr)})))
+	assert.Equal(t, []uuid.UUID{second.ID()}, ids(j.Query(JournalQuery{From: second.Timestamp(), To: third.Timestamp()})))
+	assert.Equal(t, []uuid.UUID{third.ID()}, ids(j.Query(JournalQuery{Limit: 1})))
+	assert.Nil(t, j.Query(JournalQuery{Class: ClInvalidMajor}))
+
+	t.Run("Persist", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		n, err := j.WriteTo(buf)
+		require.NoError(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		assert.Equal(t, 3, bytes.Count(buf.Bytes(), []byte("\n")))
+
+		path := filepath.Join(t.TempDir(), "journal.jsonl")
+		require.NoError(t, j.SaveFile(path))
+
+		loaded := NewJournal(2)
+		require.NoError(t, loaded.LoadFile(path))
+		assert.Equal(t, []uuid.UUID{second.ID(), third.ID()}, ids(loaded.Entries()))
+
+		entry, ok := loaded.Lookup(third.ID())
+		require.True(t, ok)
+		assert.Equal(t, "third", entry.Message)
+		assert.True(t, third.Timestamp().Equal(entry.Timestamp))
+
+		assert.Error(t, loaded.LoadFile(filepath.Join(t.TempDir(), "not-existing.jsonl")))
+		_, err = loaded.ReadFrom(bytes.NewBufferString("{invalid"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Duplicates", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		_, err := j.WriteTo(buf)
+		require.NoError(t, err)
+		lines := bytes.SplitAfter(buf.Bytes(), []byte("\n"))
+
+		// overwriting the older copy of the entry should keep the newer one addressable.
+		loaded := NewJournal(2)
+		_, err = loaded.ReadFrom(bytes.NewReader(bytes.Join([][]byte{lines[2], lines[2], lines[1]}, nil)))
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{third.ID(), second.ID()}, ids(loaded.Entries()))
+
+		_, ok := loaded.Lookup(third.ID())
+		assert.True(t, ok)
+	})
+
+	DisableJournal()
+	NewDet(clFirst, "not recorded")
+	assert.Nil(t, EnabledJournal())
+	assert.Equal(t, 3, j.Len())
+}
@@ -0,0 +1,200 @@
+// Package jsonrpc provides the JSON-RPC 2.0 error object mapping for the
+// github.com/neuronlabs/errors package.
+//
+// The error classes are mapped to the error object codes, so that the classified errors
+// might be restored from the error objects received from another service.
+// The error class, id and timestamp are carried in the error object data, together with
+// the details, operation and fields of the errors rendered with their internal information.
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/neuronlabs/errors"
+)
+
+// Version is the JSON-RPC protocol version.
+const Version = "2.0"
+
+// The range of the error codes reserved by the JSON-RPC 2.0 specification.
+const (
+	ReservedMin = -32768
+	ReservedMax = -32000
+)
+
+// The error codes defined by the JSON-RPC 2.0 specification.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+)
+
+// Code gets the JSON-RPC error code of the 'c' Class.
+// The class is mapped to the signed 32-bit integer having the same bits, so that the codes
+// fit into the integers used by most of the JSON-RPC implementations. The classes
+// which codes would fall into the reserved range are translated into their unsigned value
+// which is greater than the maximum signed 32-bit integer.
+func Code(c errors.Class) int64 {
+	code := int64(int32(c))
+	if IsReserved(code) {
+		return int64(uint32(c))
+	}
+	return code
+}
+
+// Class gets the Class mapped to the JSON-RPC error 'code'.
+// Returns false if the code is reserved or it is not a valid class code i.e. its major is zero.
+func Class(code int64) (errors.Class, bool) {
+	var class errors.Class
+	switch {
+	case IsReserved(code), code < math.MinInt32, code > math.MaxUint32:
+		return 0, false
+	case code < 0:
+		class = errors.Class(uint32(int32(code)))
+	case code > math.MaxInt32 && !IsReserved(int64(int32(uint32(code)))):
+		// only the reserved range classes are translated into the unsigned value.
+		return 0, false
+	default:
+		class = errors.Class(uint32(code))
+	}
+	if class.Major() == 0 {
+		return 0, false
+	}
+	return class, true
+}
+
+// IsReserved checks if the 'code' is within the range reserved by the JSON-RPC 2.0 specification.
+func IsReserved(code int64) bool {
+	return code >= ReservedMin && code <= ReservedMax
+}
+
+// Error is the JSON-RPC 2.0 error object.
+type Error struct {
+	Code    int64           `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// NewError creates the error object for provided 'err' with its public message and the data
+// stripped according to the errors.DefaultRedactionPolicy. See NewPublicError.
+// Returns nil if the 'err' is nil.
+func NewError(err error) *Error {
+	return NewPublicError(err, errors.DefaultRedactionPolicy)
+}
+
+// NewPublicError creates the error object for provided 'err' with the public message
+// and the data stripped according to the 'policy'. If the 'err' is a classified error or it wraps one,
+// the code is mapped from its class and the data contains its class, id and timestamp.
+// Any other error is mapped to the CodeInternalError with the DefaultPublicMessage. Returns nil if the 'err' is nil.
+func NewPublicError(err error, policy errors.RedactionPolicy) *Error {
+	if err == nil {
+		return nil
+	}
+	classError, ok := errors.AsClassError(err)
+	if !ok {
+		return &Error{Code: CodeInternalError, Message: errors.DefaultPublicMessage}
+	}
+	view := errors.RenderPublic(err, policy)
+	return newError(classError.Class(), view)
+}
+
+// NewInternalError creates the error object for provided 'err' with its internal message. If the 'err'
+// is a classified error or it wraps one, the code is mapped from its class and the data contains its
+// class, id, timestamp, details, operation and fields. Any other error is mapped to the CodeInternalError with no data.
+// It should be used only if the clients are trusted i.e. for the internal services. Returns nil if the 'err' is nil.
+func NewInternalError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	classError, ok := errors.AsClassError(err)
+	if !ok {
+		return &Error{Code: CodeInternalError, Message: err.Error()}
+	}
+	return newError(classError.Class(), errors.RenderInternal(err))
+}
+
+// Error implements error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Err gets the classified error restored from the error object.
+// If the code is not mapped to any class or the data doesn't contain the same class,
+// i.e. the error object of a foreign service, the error object itself is returned.
+func (e *Error) Err() error {
+	class, ok := Class(e.Code)
+	if !ok || len(e.Data) == 0 {
+		return e
+	}
+
+	data := Data{}
+	// the data of the foreign error objects might have any form.
+	if err := json.Unmarshal(e.Data, &data); err != nil || data.Class != class {
+		return e
+	}
+	return errors.Restore(errors.View{
+		Class:     class,
+		ID:        data.ID,
+		Timestamp: data.Timestamp,
+		Message:   e.Message,
+		Details:   data.Details,
+		Operation: data.Operation,
+		Fields:    data.Fields,
+	})
+}
+
+// Data is the error object data of the classified errors.
+type Data struct {
+	Class     errors.Class           `json:"class"`
+	ID        string                 `json:"id,omitempty"`
+	Timestamp string                 `json:"timestamp,omitempty"`
+	Details   string                 `json:"details,omitempty"`
+	Operation string                 `json:"operation,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// Response is the JSON-RPC 2.0 response object.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// NewErrorResponse creates the response for the request with provided 'id' with the error object
+// of the 'err' created by the NewError function.
+// If the 'err' is nil the response has no error object.
+func NewErrorResponse(id json.RawMessage, err error) *Response {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &Response{JSONRPC: Version, ID: id, Error: NewError(err)}
+}
+
+// Err gets the error of the response restored with the Error Err method.
+// Returns nil if the response has no error.
+func (r *Response) Err() error {
+	if r.Error == nil {
+		return nil
+	}
+	return r.Error.Err()
+}
+
+func newError(class errors.Class, view errors.View) *Error {
+	e := &Error{Code: Code(class), Message: view.Message}
+	data, err := json.Marshal(Data{
+		Class:     class,
+		ID:        view.ID,
+		Timestamp: view.Timestamp,
+		Details:   view.Details,
+		Operation: view.Operation,
+		Fields:    view.Fields,
+	})
+	if err == nil {
+		e.Data = data
+	}
+	return e
+}
@@ -0,0 +1,174 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neuronlabs/errors"
+)
+
+// TestCode tests the classes and the error codes mapping.
+func TestCode(t *testing.T) {
+	mjr := errors.MustNewMajor()
+	class := errors.MustNewClassWIndex(mjr, errors.MustNewMinor(mjr))
+
+	assert.Equal(t, int64(class), Code(class))
+
+	// the classes with the highest major are negative codes.
+	high := errors.Class(0xff<<24 | 1<<14 | 1)
+	assert.Equal(t, int64(int32(high)), Code(high))
+	assert.True(t, Code(high) < ReservedMin)
+
+	// the classes that would collide with the reserved range are translated.
+	reserved := errors.Class(uint32(0xffff8000 + 1))
+	require.True(t, IsReserved(int64(int32(reserved))))
+	assert.Equal(t, int64(uint32(reserved)), Code(reserved))
+	assert.True(t, Code(reserved) > math.MaxInt32)
+
+	for _, c := range []errors.Class{class, high, reserved} {
+		restored, ok := Class(Code(c))
+		if assert.True(t, ok) {
+			assert.Equal(t, c, restored)
+		}
+	}
+
+	for _, code := range []int64{0, 1, 100, 1<<24 - 1, CodeInternalError, ReservedMin, ReservedMax, math.MinInt32 - 1, math.MaxUint32 + 1, int64(uint32(high))} {
+		_, ok := Class(code)
+		assert.False(t, ok, "code: %d", code)
+	}
+}
+
+// TestError tests the error objects encoding and decoding.
+func TestError(t *testing.T) {
+	mjr := errors.MustNewMajor()
+	class := errors.MustNewMinorClass(mjr, errors.MustNewMinor(mjr))
+	errors.SetClassPublicMessage(class, "model not found")
+
+	detailed := errors.Build(class).Msg("user not found").Detail("user: 'john' not found").Field("model", "user").Err()
+
+	t.Run("Detailed", func(t *testing.T) {
+		data, err := json.Marshal(&Response{JSONRPC: Version, ID: json.RawMessage("1"), Error: NewInternalError(detailed)})
+		require.NoError(t, err)
+
+		resp := &Response{}
+		require.NoError(t, json.Unmarshal(data, resp))
+		assert.Equal(t, Version, resp.JSONRPC)
+		assert.Equal(t, json.RawMessage("1"), resp.ID)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, Code(class), resp.Error.Code)
+		assert.Equal(t, "user not found", resp.Error.Message)
+
+		restored, ok := resp.Err().(errors.DetailedError)
+		require.True(t, ok)
+		assert.Equal(t, class, restored.Class())
+		assert.Equal(t, detailed.ID(), restored.ID())
+		assert.True(t, detailed.(errors.Timestamper).Timestamp().Equal(restored.(errors.Timestamper).Timestamp()))
+		assert.Equal(t, "user not found", restored.Error())
+		assert.Equal(t, "user: 'john' not found", restored.Details())
+		assert.Equal(t, detailed.Operation(), restored.Operation())
+		assert.Equal(t, map[string]interface{}{"model": "user"}, restored.(errors.Fielder).Fields())
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		resp := NewErrorResponse(json.RawMessage("1"), detailed)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, Code(class), resp.Error.Code)
+		assert.Equal(t, "model not found", resp.Error.Message)
+
+		restored, ok := resp.Err().(errors.DetailedError)
+		require.True(t, ok)
+		assert.Equal(t, class, restored.Class())
+		assert.Equal(t, detailed.ID(), restored.ID())
+		assert.Equal(t, "model not found", restored.Error())
+		assert.Empty(t, restored.Details())
+		assert.Empty(t, restored.Operation())
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		e := NewInternalError(fmt.Errorf("handler: %w", errors.New(class, "simple")))
+		assert.Equal(t, Code(class), e.Code)
+		assert.Equal(t, "handler: simple", e.Message)
+
+		restored, ok := e.Err().(errors.ClassError)
+		require.True(t, ok)
+		assert.Equal(t, class, restored.Class())
+		_, ok = restored.(errors.DetailedError)
+		assert.False(t, ok)
+	})
+
+	t.Run("Public", func(t *testing.T) {
+		e := NewPublicError(detailed, errors.DefaultRedactionPolicy)
+		assert.Equal(t, Code(class), e.Code)
+		assert.Equal(t, "model not found", e.Message)
+
+		data := Data{}
+		require.NoError(t, json.Unmarshal(e.Data, &data))
+		assert.Equal(t, detailed.ID().String(), data.ID)
+		assert.Empty(t, data.Details)
+		assert.Empty(t, data.Operation)
+		assert.Nil(t, data.Fields)
+
+		e = NewPublicError(io.EOF, errors.DefaultRedactionPolicy)
+		assert.Equal(t, int64(CodeInternalError), e.Code)
+		assert.Equal(t, errors.DefaultPublicMessage, e.Message)
+	})
+
+	t.Run("Foreign", func(t *testing.T) {
+		resp := &Response{}
+		require.NoError(t, json.Unmarshal([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"Method not found"}}`), resp))
+		err := resp.Err()
+		e, ok := err.(*Error)
+		require.True(t, ok)
+		assert.Equal(t, int64(CodeMethodNotFound), e.Code)
+		assert.Equal(t, "Method not found", err.Error())
+
+		// the error objects with the codes of the class range are restored only with the matching class data.
+		for _, data := range []string{``, `,"data":"text"`, fmt.Sprintf(`,"data":{"class":%d}`, class+1)} {
+			resp = &Response{}
+			require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"error":{"code":%d,"message":"foreign"%s}}`, Code(class), data)), resp))
+			e, ok = resp.Err().(*Error)
+			require.True(t, ok, data)
+			assert.Equal(t, "foreign", e.Error())
+		}
+
+		resp = &Response{}
+		require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"error":{"code":%d,"message":"foreign","data":{"class":%d}}}`, Code(class), class)), resp))
+		restored, ok := resp.Err().(errors.ClassError)
+		require.True(t, ok)
+		assert.Equal(t, class, restored.Class())
+		assert.Equal(t, "foreign", restored.Error())
+
+		resp = &Response{}
+		require.NoError(t, json.Unmarshal([]byte(`{"jsonrpc":"2.0","id":1,"result":true}`), resp))
+		assert.NoError(t, resp.Err())
+	})
+
+	t.Run("Other", func(t *testing.T) {
+		e := NewInternalError(io.EOF)
+		assert.Equal(t, int64(CodeInternalError), e.Code)
+		assert.Equal(t, "EOF", e.Message)
+		assert.Nil(t, e.Data)
+		assert.Equal(t, e, e.Err())
+
+		resp := NewErrorResponse(nil, io.EOF)
+		data, err := json.Marshal(resp)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32603,"message":"internal error"}}`, string(data))
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Nil(t, NewError(nil))
+		assert.Nil(t, NewPublicError(nil, errors.DefaultRedactionPolicy))
+		assert.Nil(t, NewInternalError(nil))
+
+		resp := NewErrorResponse(json.RawMessage("1"), nil)
+		assert.Nil(t, resp.Error)
+		assert.NoError(t, resp.Err())
+	})
+}
@@ -0,0 +1,101 @@
+package errors
+
+import (
+	stderrors "errors"
+	"sort"
+	"strconv"
+)
+
+// LogField is a single key-value pair of the structured error description.
+// The Value is one of: string, uint64, int, time.Time, []LogField for the nested groups
+// or any value of the error fields.
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// LogFields describes the 'err' as the ordered structured key-value pairs.
+// It is the common description used by the structured loggers integrations.
+//
+// A classified error is described with its message, class, class name, severity,
+// id, timestamp, details, operation, fields and the cause. The errors wrapping a classified error
+// are described as the classified error with the message of the wrapping error.
+// A MultiError is described with the number of its errors and each error keyed by its index.
+// Any other error is described with its message only. A nil error has no fields.
+func LogFields(err error) []LogField {
+	if err == nil {
+		return nil
+	}
+	if m, ok := err.(MultiError); ok {
+		fields := make([]LogField, 0, len(m)+1)
+		fields = append(fields, LogField{Key: "count", Value: len(m)})
+		for i, e := range m {
+			fields = append(fields, LogField{Key: strconv.Itoa(i), Value: logFieldValue(e)})
+		}
+		return fields
+	}
+
+	fields := []LogField{{Key: "message", Value: err.Error()}}
+	classError, ok := AsClassError(err)
+	if !ok {
+		return fields
+	}
+
+	class := classError.Class()
+	fields = append(fields, LogField{Key: "class", Value: uint64(class)})
+	if name := ClassName(class); name != "" {
+		fields = append(fields, LogField{Key: "class_name", Value: name})
+	}
+	fields = append(fields, LogField{Key: "severity", Value: SeverityOf(classError).String()})
+
+	if e, ok := classError.(Indexer); ok {
+		fields = append(fields, LogField{Key: "id", Value: e.ID().String()})
+	}
+	if e, ok := classError.(Timestamper); ok {
+		fields = append(fields, LogField{Key: "timestamp", Value: e.Timestamp()})
+	}
+	if e, ok := classError.(Detailer); ok {
+		if details := e.Details(); details != "" {
+			fields = append(fields, LogField{Key: "details", Value: details})
+		}
+	}
+	if e, ok := classError.(Operationer); ok {
+		if operation := e.Operation(); operation != "" {
+			fields = append(fields, LogField{Key: "operation", Value: operation})
+		}
+	}
+	if e, ok := classError.(Fielder); ok {
+		if errorFields := e.Fields(); len(errorFields) > 0 {
+			keys := make([]string, 0, len(errorFields))
+			for k := range errorFields {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			group := make([]LogField, len(keys))
+			for i, k := range keys {
+				group[i] = LogField{Key: k, Value: errorFields[k]}
+			}
+			fields = append(fields, LogField{Key: "fields", Value: group})
+		}
+	}
+	if cause := stderrors.Unwrap(classError); cause != nil {
+		fields = append(fields, LogField{Key: "cause", Value: logFieldValue(cause)})
+	}
+	return fields
+}
+
+// logFieldValue gets the nested LogFields group for the classified and multi errors
+// and the error message for any other error.
+func logFieldValue(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(MultiError); ok {
+		return LogFields(err)
+	}
+	if _, ok := AsClassError(err); ok {
+		return LogFields(err)
+	}
+	return err.Error()
+}
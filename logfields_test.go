@@ -0,0 +1,51 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLogFields tests the structured error description.
+func TestLogFields(t *testing.T) {
+	resetContainer()
+
+	ts := time.Date(2019, 9, 1, 12, 30, 15, 0, time.UTC)
+	clock = func() time.Time { return ts }
+	defer func() { clock = time.Now }()
+
+	SetClassName(ClInvalidIndex, "invalid_index")
+	err := Build(ClInvalidIndex).Msg("detailed").Field("b", 2).Field("a", 1).Cause(fmt.Errorf("wrapped: %w", New(ClInvalidMajor, "cause"))).Err()
+
+	assert.Equal(t, []LogField{
+		{Key: "message", Value: "detailed"},
+		{Key: "class", Value: uint64(ClInvalidIndex)},
+		{Key: "class_name", Value: "invalid_index"},
+		{Key: "severity", Value: "error"},
+		{Key: "id", Value: err.ID().String()},
+		{Key: "timestamp", Value: ts},
+		{Key: "operation", Value: err.Operation()},
+		{Key: "fields", Value: []LogField{{Key: "a", Value: 1}, {Key: "b", Value: 2}}},
+		{Key: "cause", Value: []LogField{
+			{Key: "message", Value: "wrapped: cause"},
+			{Key: "class", Value: uint64(ClInvalidMajor)},
+			{Key: "severity", Value: "error"},
+		}},
+	}, LogFields(err))
+
+	assert.Equal(t, []LogField{{Key: "message", Value: "EOF"}}, LogFields(io.EOF))
+	assert.Equal(t, []LogField{
+		{Key: "count", Value: 2},
+		{Key: "0", Value: "EOF"},
+		{Key: "1", Value: []LogField{
+			{Key: "message", Value: "simple"},
+			{Key: "class", Value: uint64(ClInvalidMinor)},
+			{Key: "severity", Value: "error"},
+		}},
+	}, LogFields(MultiError{io.EOF, New(ClInvalidMinor, "simple")}))
+
+	assert.Nil(t, LogFields(nil))
+}
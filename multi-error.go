@@ -1,31 +1,272 @@
 package errors
 
 import (
+	"strconv"
 	"strings"
 )
 
-// MultiError is the slice of errors parsable into a single error.
-type MultiError []ClassError
+// DefaultMultiErrorFormat is the package default format used by the MultiError Error method.
+var DefaultMultiErrorFormat = MultiErrorFormat{Separator: ", "}
+
+// MultiErrorFormat defines how the MultiError messages are joined into a single message.
+type MultiErrorFormat struct {
+	// Separator is placed between the error messages.
+	Separator string
+	// Numbered prefixes each message with its ordinal number i.e. '1. '.
+	Numbered bool
+	// Lines places each message in a separate line prefixed with the error class
+	// in a form of '[major.minor.index] '. The Separator is not used in this case.
+	Lines bool
+	// Dedupe skips the messages identical to the ones already formatted.
+	Dedupe bool
+	// Limit is the maximum number of formatted messages. The rest of the
+	// messages are summarized as 'and K more'. Zero means no limit.
+	Limit int
+}
+
+// Join joins the messages of the 'm' MultiError into a single message.
+func (f MultiErrorFormat) Join(m MultiError) string {
+	separator := f.Separator
+	if f.Lines {
+		separator = "\n"
+	}
 
-// Error implements error interface.
-func (m MultiError) Error() string {
 	sb := &strings.Builder{}
+	seen := map[string]struct{}{}
+	var written, more int
+	for _, err := range m {
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		if f.Dedupe {
+			if _, ok := seen[msg]; ok {
+				continue
+			}
+			seen[msg] = struct{}{}
+		}
+		if f.Limit > 0 && written == f.Limit {
+			more++
+			continue
+		}
 
-	for i, e := range m {
-		sb.WriteString(e.Error())
-		if i != len(m)-1 {
-			sb.WriteString(",")
+		if written > 0 {
+			sb.WriteString(separator)
+		}
+		written++
+		if f.Numbered {
+			sb.WriteString(strconv.Itoa(written))
+			sb.WriteString(". ")
 		}
+		if class, ok := classOf(err); ok && f.Lines {
+			sb.WriteString("[")
+			sb.WriteString(class.Key())
+			sb.WriteString("] ")
+		}
+		sb.WriteString(msg)
+	}
+
+	if more > 0 {
+		if written > 0 {
+			sb.WriteString(separator)
+		}
+		sb.WriteString("and ")
+		sb.WriteString(strconv.Itoa(more))
+		sb.WriteString(" more")
 	}
 	return sb.String()
 }
 
+// FormattedMultiError is the MultiError with its own message format.
+type FormattedMultiError struct {
+	MultiError
+	Format MultiErrorFormat
+}
+
+// Error implements error interface.
+func (f *FormattedMultiError) Error() string {
+	return f.Format.Join(f.MultiError)
+}
+
+// MultiError is the slice of errors parsable into a single error.
+// It implements 'Unwrap() []error' so that the standard library 'errors.Is'
+// and 'errors.As' functions checks each of its errors.
+type MultiError []error
+
+// Append creates a MultiError from the 'err' and 'errs' errors.
+// The nil errors are skipped and the errors of nested MultiErrors are flattened.
+// If the 'err' is a MultiError its errors are copied, so that
+// the input slice is never modified.
+// Returns nil if there are no non-nil errors.
+func Append(err error, errs ...error) MultiError {
+	var m MultiError
+	m = m.append(err)
+	for _, e := range errs {
+		m = m.append(e)
+	}
+	return m
+}
+
+// Error implements error interface.
+// The messages are joined using the DefaultMultiErrorFormat.
+func (m MultiError) Error() string {
+	return DefaultMultiErrorFormat.Join(m)
+}
+
+// WithFormat gets the multi error with its own message 'format'.
+func (m MultiError) WithFormat(format MultiErrorFormat) *FormattedMultiError {
+	return &FormattedMultiError{MultiError: m, Format: format}
+}
+
+// ErrorOrNil returns nil if the multi error is empty or the multi error itself otherwise.
+// It should be used while returning the MultiError as an error interface.
+func (m MultiError) ErrorOrNil() error {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// Unwrap gets the errors contained in the multi error.
+// Used by the standard library 'errors.Is' and 'errors.As' functions.
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
 // HasMajor checks if provided 'mjr' occurs in given multi error slice.
 func (m MultiError) HasMajor(mjr Major) bool {
 	for _, err := range m {
-		if err.Class().Major() == mjr {
+		if c, ok := classOf(err); ok && c.Major() == mjr {
 			return true
 		}
 	}
 	return false
 }
+
+// HasMinor checks if provided 'mnr' Minor of the 'mjr' Major occurs in given multi error slice.
+func (m MultiError) HasMinor(mjr Major, mnr Minor) bool {
+	for _, err := range m {
+		if c, ok := classOf(err); ok && c.Major() == mjr && c.Minor() == mnr {
+			return true
+		}
+	}
+	return false
+}
+
+// HasClass checks if an error with exactly the 'c' Class occurs in given multi error slice.
+func (m MultiError) HasClass(c Class) bool {
+	for _, err := range m {
+		if class, ok := classOf(err); ok && class == c {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains checks if an error of the 'c' Class occurs in given multi error slice.
+// If the 'c' is a major or minor class, all the errors with the same major
+// or the same major and minor are matched.
+func (m MultiError) Contains(c Class) bool {
+	return m.First(c) != nil
+}
+
+// First gets the first error of the 'c' Class. The classes are matched the same way as in the Contains method.
+// Returns nil if no error matches.
+func (m MultiError) First(c Class) ClassError {
+	for _, err := range m {
+		if classError, ok := AsClassError(err); ok && c.contains(classError.Class()) {
+			return classError
+		}
+	}
+	return nil
+}
+
+// ByClass gets all the errors of the 'c' Class. The classes are matched the same way as in the Contains method.
+func (m MultiError) ByClass(c Class) MultiError {
+	return m.Filter(func(err error) bool {
+		class, ok := classOf(err)
+		return ok && c.contains(class)
+	})
+}
+
+// Filter gets the errors for which the 'filter' function returns true.
+func (m MultiError) Filter(filter func(err error) bool) MultiError {
+	var filtered MultiError
+	for _, err := range m {
+		if filter(err) {
+			filtered = append(filtered, err)
+		}
+	}
+	return filtered
+}
+
+// GroupByMajor groups the errors by their class major.
+// The errors without classification are grouped under zero valued Major.
+func (m MultiError) GroupByMajor() map[Major]MultiError {
+	groups := map[Major]MultiError{}
+	for _, err := range m {
+		class, _ := classOf(err)
+		groups[class.Major()] = append(groups[class.Major()], err)
+	}
+	return groups
+}
+
+// Classes gets the deduplicated classes of the errors in order of their occurrence.
+// The errors without classification are omitted.
+func (m MultiError) Classes() []Class {
+	var classes []Class
+	seen := map[Class]struct{}{}
+	for _, err := range m {
+		class, ok := classOf(err)
+		if !ok {
+			continue
+		}
+		if _, ok := seen[class]; ok {
+			continue
+		}
+		seen[class] = struct{}{}
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+// FilterSeverity gets the errors with the severity equal or more serious than the 'min' Severity.
+func (m MultiError) FilterSeverity(min Severity) MultiError {
+	return m.Filter(func(err error) bool {
+		return SeverityOf(err).AtLeast(min)
+	})
+}
+
+// MaxSeverity gets the most serious severity of the errors in given multi error slice.
+// Returns zero value Severity if the multi error is empty.
+func (m MultiError) MaxSeverity() Severity {
+	var max Severity
+	for _, err := range m {
+		if s := SeverityOf(err); s > max {
+			max = s
+		}
+	}
+	return max
+}
+
+func (m MultiError) append(err error) MultiError {
+	switch e := err.(type) {
+	case nil:
+	case MultiError:
+		for _, nested := range e {
+			m = m.append(nested)
+		}
+	default:
+		m = append(m, err)
+	}
+	return m
+}
+
+// classOf gets the class of the first ClassError found in the 'err' chain.
+func classOf(err error) (Class, bool) {
+	classError, ok := AsClassError(err)
+	if !ok {
+		return 0, false
+	}
+	return classError.Class(), true
+}
@@ -0,0 +1,125 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMultiError tests the MultiError functions.
+func TestMultiError(t *testing.T) {
+	resetContainer()
+
+	t.Run("Append", func(t *testing.T) {
+		simple := New(ClInvalidMajor, "simple")
+		detailed := NewDet(ClInvalidIndex, "detailed")
+
+		assert.Nil(t, Append(nil))
+		assert.Nil(t, Append(nil, nil, MultiError{}))
+		assert.NoError(t, Append(nil, nil).ErrorOrNil())
+
+		m := Append(nil, io.EOF, nil, simple)
+		assert.Equal(t, MultiError{io.EOF, simple}, m)
+
+		nested := Append(m, MultiError{detailed, MultiError{io.ErrUnexpectedEOF, nil}})
+		assert.Equal(t, MultiError{io.EOF, simple, detailed, io.ErrUnexpectedEOF}, nested)
+		// the input multi error should not be modified.
+		assert.Len(t, m, 2)
+
+		assert.Equal(t, MultiError{io.EOF}, Append(io.EOF))
+		assert.Error(t, m.ErrorOrNil())
+		assert.Equal(t, "EOF, simple", m.Error())
+	})
+
+	t.Run("Unwrap", func(t *testing.T) {
+		detailed := NewDet(ClInvalidIndex, "detailed")
+		wrapped := fmt.Errorf("driver: %w", New(ClInvalidMinor, "wrapped"))
+
+		var err error = Append(io.EOF, wrapped, detailed)
+		assert.True(t, stderrors.Is(err, io.EOF))
+		assert.True(t, stderrors.Is(err, detailed))
+		assert.False(t, stderrors.Is(err, io.ErrUnexpectedEOF))
+
+		var classError ClassError
+		require.True(t, stderrors.As(err, &classError))
+		assert.Equal(t, ClInvalidMinor, classError.Class())
+
+		var detailedError DetailedError
+		require.True(t, stderrors.As(err, &detailedError))
+		assert.Equal(t, detailed.ID(), detailedError.ID())
+
+		assert.True(t, Append(io.EOF, wrapped).HasMajor(ClInvalidMinor.Major()))
+		assert.False(t, Append(io.EOF).HasMajor(ClInvalidMinor.Major()))
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		mjr := MustNewMajor()
+		mnr := MustNewMinor(mjr)
+		clFirst := MustNewClassWIndex(mjr, mnr)
+		clSecond := MustNewClassWIndex(mjr, mnr)
+		clOther := MustNewMinorClass(mjr, MustNewMinor(mjr))
+		mnrClass := MustNewMinorClass(mjr, mnr)
+
+		first := NewDet(clFirst, "first")
+		second := fmt.Errorf("wrapped: %w", New(clSecond, "second"))
+		other := New(clOther, "other")
+		internal := New(ClInvalidMajor, "internal")
+
+		m := Append(first, io.EOF, second, other, internal, New(clFirst, "first again"))
+
+		assert.True(t, m.HasClass(clSecond))
+		assert.False(t, m.HasClass(mnrClass))
+		assert.True(t, m.HasMinor(mjr, mnr))
+		assert.True(t, m.HasMinor(ClInvalidMajor.Major(), ClInvalidMajor.Minor()))
+		assert.False(t, m.HasMinor(ClInvalidIndex.Major(), ClInvalidIndex.Minor()))
+
+		assert.True(t, m.Contains(mnrClass))
+		assert.True(t, m.Contains(MustNewMajorClass(mjr)))
+		assert.True(t, m.Contains(clOther))
+		assert.False(t, m.Contains(ClInvalidIndex))
+		assert.False(t, m.Contains(MustNewMinorClass(mjr, MustNewMinor(mjr))))
+
+		assert.Equal(t, first, m.First(mnrClass))
+		assert.Equal(t, clSecond, m.First(clSecond).Class())
+		assert.Nil(t, m.First(ClInvalidIndex))
+
+		assert.Equal(t, MultiError{first, second, m[5]}, m.ByClass(mnrClass))
+		assert.Equal(t, MultiError{first, second, other, m[5]}, m.ByClass(MustNewMajorClass(mjr)))
+		assert.Nil(t, m.ByClass(ClInvalidIndex))
+
+		assert.Equal(t, MultiError{io.EOF}, m.Filter(func(err error) bool { return err == io.EOF }))
+
+		groups := m.GroupByMajor()
+		assert.Len(t, groups, 3)
+		assert.Equal(t, MultiError{io.EOF}, groups[0])
+		assert.Equal(t, MultiError{internal}, groups[ClInvalidMajor.Major()])
+		assert.Len(t, groups[mjr], 4)
+
+		assert.Equal(t, []Class{clFirst, clSecond, clOther, ClInvalidMajor}, m.Classes())
+		assert.Nil(t, MultiError{io.EOF}.Classes())
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		m := Append(New(ClInvalidMajor, "first, with comma"), io.EOF, New(ClInvalidIndex, "third"), io.EOF, io.EOF)
+
+		assert.Equal(t, "first, with comma, EOF, third, EOF, EOF", m.Error())
+		assert.Equal(t, "first, with comma; EOF; third", m.WithFormat(MultiErrorFormat{Separator: "; ", Dedupe: true}).Error())
+		assert.Equal(t, "1. first, with comma | 2. EOF | and 3 more", m.WithFormat(MultiErrorFormat{Separator: " | ", Numbered: true, Limit: 2}).Error())
+		assert.Equal(t, "[1.1.0] first, with comma\nEOF\nand 1 more", m.WithFormat(MultiErrorFormat{Lines: true, Dedupe: true, Limit: 2}).Error())
+
+		formatted := m.WithFormat(MultiErrorFormat{Lines: true, Numbered: true})
+		assert.Equal(t, "1. [1.1.0] first, with comma\n2. EOF\n3. [1.3.0] third\n4. EOF\n5. EOF", formatted.Error())
+		assert.True(t, stderrors.Is(formatted, io.EOF))
+		assert.True(t, formatted.HasClass(ClInvalidIndex))
+
+		defaultFormat := DefaultMultiErrorFormat
+		defer func() { DefaultMultiErrorFormat = defaultFormat }()
+		DefaultMultiErrorFormat = MultiErrorFormat{Separator: "\n", Limit: 1}
+		assert.Equal(t, "first, with comma\nand 4 more", m.Error())
+		assert.Equal(t, "", MultiError{}.Error())
+	})
+}
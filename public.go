@@ -0,0 +1,44 @@
+package errors
+
+// DefaultPublicMessage is the public message used for the errors
+// which class has no public message defined.
+var DefaultPublicMessage = "internal error"
+
+// SetClassPublicMessage sets the default public 'message' for the errors of the 'c' Class.
+// The message is also used by the classes with the same minor or major
+// unless they have their own public message defined.
+// The 'c' might be the major, minor or index class.
+func SetClassPublicMessage(c Class, message string) {
+	container.setInfo(c, func(info *classInfo) {
+		info.publicMessage = message
+	})
+}
+
+// ClassPublicMessage gets the public message defined for the 'c' Class, its minor or major.
+// If none of them has the public message defined the DefaultPublicMessage is returned.
+func ClassPublicMessage(c Class) string {
+	message := DefaultPublicMessage
+	container.findInfo(c, func(info *classInfo) bool {
+		if info.publicMessage == "" {
+			return false
+		}
+		message = info.publicMessage
+		return true
+	})
+	return message
+}
+
+// PublicMessage gets the message of the 'err' that is safe to be shown to the external consumers.
+// The message is taken from the first classified error found in the 'err' chain.
+// If it doesn't implement PublicMessager, the public message of it's class is returned.
+// Errors without classification returns DefaultPublicMessage.
+func PublicMessage(err error) string {
+	classError, ok := AsClassError(err)
+	if !ok {
+		return DefaultPublicMessage
+	}
+	if e, ok := classError.(PublicMessager); ok {
+		return e.PublicMessage()
+	}
+	return ClassPublicMessage(classError.Class())
+}
@@ -0,0 +1,135 @@
+package errors
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultRedactionPolicy is the default redaction policy that strips all the
+// internal information from the rendered errors.
+var DefaultRedactionPolicy = RedactionPolicy{
+	RedactDetails:   true,
+	RedactFields:    true,
+	RedactOperation: true,
+}
+
+// RedactionPolicy defines which error information should be stripped
+// while rendering the error for the external consumers.
+type RedactionPolicy struct {
+	// RedactDetails strips the error details.
+	RedactDetails bool
+	// RedactFields strips the error fields except the ones defined in the PublicFields.
+	RedactFields bool
+	// RedactOperation strips the error runtime operations.
+	RedactOperation bool
+	// PublicFields are the field keys that are never stripped.
+	PublicFields []string
+}
+
+// View is the rendered form of an error.
+type View struct {
+	Class     Class                  `json:"class"`
+	ID        string                 `json:"id,omitempty"`
+	Timestamp string                 `json:"timestamp,omitempty"`
+	Message   string                 `json:"message"`
+	Details   string                 `json:"details,omitempty"`
+	Operation string                 `json:"operation,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// RenderInternal renders the internal view of the 'err' containing all its information.
+// The errors wrapping a classified error are rendered as the classified error with the message of the wrapping error.
+// A nil 'err' is rendered as the zero value View.
+// The view should not be exposed to the external consumers.
+func RenderInternal(err error) View {
+	if err == nil {
+		return View{}
+	}
+	view := View{Message: err.Error()}
+	classError, ok := AsClassError(err)
+	if !ok {
+		return view
+	}
+	view.Class = classError.Class()
+	if e, ok := classError.(Indexer); ok {
+		view.ID = e.ID().String()
+	}
+	if e, ok := classError.(Timestamper); ok {
+		view.Timestamp = e.Timestamp().Format(time.RFC3339Nano)
+	}
+	if e, ok := classError.(Detailer); ok {
+		view.Details = e.Details()
+	}
+	if e, ok := classError.(Operationer); ok {
+		view.Operation = e.Operation()
+	}
+	if e, ok := classError.(Fielder); ok && len(e.Fields()) > 0 {
+		view.Fields = make(map[string]interface{}, len(e.Fields()))
+		for k, v := range e.Fields() {
+			view.Fields[k] = v
+		}
+	}
+	return view
+}
+
+// RenderPublic renders the public view of the 'err' with the public message
+// and the information stripped according to the 'policy'.
+// A nil 'err' is rendered as the zero value View.
+func RenderPublic(err error, policy RedactionPolicy) View {
+	if err == nil {
+		return View{}
+	}
+	view := RenderInternal(err)
+	view.Message = PublicMessage(err)
+	if policy.RedactDetails {
+		view.Details = ""
+	}
+	if policy.RedactOperation {
+		view.Operation = ""
+	}
+	if policy.RedactFields && view.Fields != nil {
+		fields := map[string]interface{}{}
+		for _, key := range policy.PublicFields {
+			if v, ok := view.Fields[key]; ok {
+				fields[key] = v
+			}
+		}
+		view.Fields = nil
+		if len(fields) > 0 {
+			view.Fields = fields
+		}
+	}
+	return view
+}
+
+// Restore restores the error from its rendered 'view' i.e. the view received from another service.
+// If the view has a valid ID the DetailedError is restored with its id, timestamp, details, operation and fields.
+// Otherwise the simple ClassError is restored. The restored errors are not reported to the hooks
+// nor counted, as they were already reported by the side that rendered them.
+func Restore(view View) ClassError {
+	id, err := uuid.Parse(view.ID)
+	if err != nil {
+		return &simpleError{class: view.Class, msg: view.Message}
+	}
+
+	restored := &detailedError{
+		id:        id,
+		class:     view.Class,
+		message:   view.Message,
+		operation: view.Operation,
+	}
+	if view.Timestamp != "" {
+		restored.timestamp, _ = time.Parse(time.RFC3339Nano, view.Timestamp)
+	}
+	if view.Details != "" {
+		restored.details = []Detail{{Text: view.Details}}
+	}
+	if len(view.Fields) > 0 {
+		restored.fields = make(map[string]interface{}, len(view.Fields))
+		for k, v := range view.Fields {
+			restored.fields[k] = v
+		}
+	}
+	return restored
+}
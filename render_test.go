@@ -0,0 +1,104 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRender tests the public and internal error rendering.
+func TestRender(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	mnr := MustNewMinor(mjr)
+	clQuery := MustNewClassWIndex(mjr, mnr)
+	clOther := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	SetClassPublicMessage(MustNewMinorClass(mjr, mnr), "repository query failed")
+	SetClassPublicMessage(MustNewMajorClass(mjr), "repository failure")
+
+	assert.Equal(t, "repository query failed", ClassPublicMessage(clQuery))
+	assert.Equal(t, "repository failure", ClassPublicMessage(clOther))
+	assert.Equal(t, DefaultPublicMessage, ClassPublicMessage(ClInvalidMajor))
+
+	err := NewDet(clQuery, "pq: relation 'users' does not exist").(*detailedError)
+	err.SetDetails("SELECT * FROM users")
+	err.SetField("table", "users")
+	err.SetField("model", "user")
+
+	assert.Equal(t, "repository query failed", PublicMessage(err))
+	assert.Equal(t, "repository failure", PublicMessage(New(clOther, "/var/lib/data: permission denied")))
+	assert.Equal(t, DefaultPublicMessage, PublicMessage(stderrors.New("plain")))
+
+	err.SetPublicMessage("model not found")
+	assert.Equal(t, "model not found", err.PublicMessage())
+	assert.Equal(t, "model not found", PublicMessage(fmt.Errorf("query: %w", err)))
+	assert.Equal(t, "repository failure", PublicMessage(fmt.Errorf("read: %w", New(clOther, "permission denied"))))
+
+	internal := RenderInternal(err)
+	assert.Equal(t, clQuery, internal.Class)
+	assert.Equal(t, err.ID().String(), internal.ID)
+	assert.Equal(t, "pq: relation 'users' does not exist", internal.Message)
+	assert.Equal(t, "SELECT * FROM users", internal.Details)
+	assert.Equal(t, err.Operation(), internal.Operation)
+	assert.Len(t, internal.Fields, 2)
+
+	public := RenderPublic(err, DefaultRedactionPolicy)
+	assert.Equal(t, clQuery, public.Class)
+	assert.Equal(t, err.ID().String(), public.ID)
+	assert.Equal(t, "model not found", public.Message)
+	assert.Empty(t, public.Details)
+	assert.Empty(t, public.Operation)
+	assert.Nil(t, public.Fields)
+
+	policy := RedactionPolicy{RedactFields: true, PublicFields: []string{"model"}}
+	public = RenderPublic(err, policy)
+	assert.Equal(t, "SELECT * FROM users", public.Details)
+	assert.Equal(t, err.Operation(), public.Operation)
+	assert.Equal(t, map[string]interface{}{"model": "user"}, public.Fields)
+
+	// the wrapped classified errors are rendered with the message of the wrapping error.
+	wrapped := RenderPublic(fmt.Errorf("query: %w", err), DefaultRedactionPolicy)
+	assert.Equal(t, clQuery, wrapped.Class)
+	assert.Equal(t, err.ID().String(), wrapped.ID)
+	assert.Equal(t, internal.Timestamp, wrapped.Timestamp)
+	assert.Equal(t, "model not found", wrapped.Message)
+	assert.Equal(t, "query: pq: relation 'users' does not exist", RenderInternal(fmt.Errorf("query: %w", err)).Message)
+
+	assert.Equal(t, View{Message: "plain"}, RenderInternal(stderrors.New("plain")))
+	assert.Equal(t, View{}, RenderInternal(nil))
+	assert.Equal(t, View{}, RenderPublic(nil, DefaultRedactionPolicy))
+}
+
+// TestRestore tests restoring the errors from their rendered views.
+func TestRestore(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	class := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	err := NewDet(class, "detailed").(*detailedError)
+	err.SetDetails("unknown field")
+	err.SetField("model", "user")
+
+	restored := Restore(RenderInternal(err))
+	detailed, ok := restored.(*detailedError)
+	require.True(t, ok)
+	assert.Equal(t, class, detailed.Class())
+	assert.Equal(t, err.ID(), detailed.ID())
+	assert.True(t, err.Timestamp().Equal(detailed.Timestamp()))
+	assert.Equal(t, "detailed", detailed.Error())
+	assert.Equal(t, "unknown field", detailed.Details())
+	assert.Equal(t, err.Operation(), detailed.Operation())
+	assert.Equal(t, map[string]interface{}{"model": "user"}, detailed.Fields())
+
+	restored = Restore(RenderInternal(New(class, "simple")))
+	_, ok = restored.(DetailedError)
+	assert.False(t, ok)
+	assert.Equal(t, class, restored.Class())
+	assert.Equal(t, "simple", restored.Error())
+}
@@ -0,0 +1,84 @@
+package errors
+
+// Severity defines how serious an error is.
+// The greater the value the more serious the error.
+type Severity uint8
+
+// Enumerated severity levels.
+const (
+	// SeverityDebug is the severity for the errors that are relevant only for debugging purpose.
+	SeverityDebug Severity = iota + 1
+	// SeverityInfo is the severity for the errors that are expected and informational only.
+	SeverityInfo
+	// SeverityWarning is the severity for the errors that should be noticed but doesn't break the logic.
+	SeverityWarning
+	// SeverityError is the severity for the errors that breaks the logic.
+	SeverityError
+	// SeverityCritical is the severity for the errors that requires immediate attention.
+	SeverityCritical
+)
+
+// DefaultSeverity is the severity used for errors which class has no severity defined.
+var DefaultSeverity = SeverityError
+
+// String implements fmt.Stringer interface.
+func (s Severity) String() string {
+	switch s {
+	case SeverityDebug:
+		return "debug"
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "undefined"
+	}
+}
+
+// AtLeast checks if the severity is equal or more serious than the 'min' Severity.
+func (s Severity) AtLeast(min Severity) bool {
+	return s >= min
+}
+
+// SetClassSeverity sets the severity 's' for the errors of the 'c' Class.
+// The severity is also used by the classes with the same minor or major
+// unless they have their own severity defined.
+// The 'c' might be the major, minor or index class.
+func SetClassSeverity(c Class, s Severity) {
+	container.setInfo(c, func(info *classInfo) {
+		info.severity = s
+	})
+}
+
+// ClassSeverity gets the severity defined for the 'c' Class, its minor or major.
+// If none of them has the severity defined the DefaultSeverity is returned.
+func ClassSeverity(c Class) Severity {
+	severity := DefaultSeverity
+	container.findInfo(c, func(info *classInfo) bool {
+		if info.severity == 0 {
+			return false
+		}
+		severity = info.severity
+		return true
+	})
+	return severity
+}
+
+// SeverityOf gets the severity of provided 'err'.
+// The severity is taken from the first classified error found in the 'err' chain.
+// If it doesn't implement Severitier, the severity of it's class is returned.
+// Errors without classification are of DefaultSeverity.
+func SeverityOf(err error) Severity {
+	classError, ok := AsClassError(err)
+	if !ok {
+		return DefaultSeverity
+	}
+	if e, ok := classError.(Severitier); ok {
+		return e.Severity()
+	}
+	return ClassSeverity(classError.Class())
+}
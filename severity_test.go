@@ -0,0 +1,61 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSeverity tests the class and error severities.
+func TestSeverity(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	mnr := MustNewMinor(mjr)
+	clIndex := MustNewClassWIndex(mjr, mnr)
+	clCritical := MustNewClassWIndex(mjr, mnr)
+	clOther := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	assert.Equal(t, DefaultSeverity, ClassSeverity(clIndex))
+
+	SetClassSeverity(MustNewMajorClass(mjr), SeverityWarning)
+	SetClassSeverity(MustNewMinorClass(mjr, mnr), SeverityInfo)
+	SetClassSeverity(clCritical, SeverityCritical)
+
+	assert.Equal(t, SeverityInfo, ClassSeverity(clIndex))
+	assert.Equal(t, SeverityCritical, ClassSeverity(clCritical))
+	assert.Equal(t, SeverityWarning, ClassSeverity(clOther))
+
+	detailed := NewDet(clIndex, "detailed").(*detailedError)
+	assert.Equal(t, SeverityInfo, detailed.Severity())
+	detailed.SetSeverity(SeverityDebug)
+	assert.Equal(t, SeverityDebug, detailed.Severity())
+
+	assert.Equal(t, SeverityDebug, SeverityOf(detailed))
+	assert.Equal(t, SeverityWarning, SeverityOf(New(clOther, "simple")))
+	assert.Equal(t, DefaultSeverity, SeverityOf(stderrors.New("plain")))
+
+	assert.True(t, SeverityCritical.AtLeast(SeverityError))
+	assert.True(t, SeverityError.AtLeast(SeverityError))
+	assert.False(t, SeverityDebug.AtLeast(SeverityInfo))
+	assert.Equal(t, "warning", SeverityWarning.String())
+	assert.Equal(t, "undefined", Severity(0).String())
+
+	critical := New(clCritical, "critical")
+	multi := MultiError{detailed, New(clOther, "simple"), critical}
+	assert.Equal(t, MultiError{New(clOther, "simple"), critical}, multi.FilterSeverity(SeverityWarning))
+	assert.Equal(t, MultiError{critical}, multi.FilterSeverity(SeverityError))
+	assert.Nil(t, multi.FilterSeverity(Severity(SeverityCritical+1)))
+	assert.Equal(t, SeverityCritical, multi.MaxSeverity())
+	assert.Equal(t, Severity(0), MultiError{}.MaxSeverity())
+
+	// the wrapped classified errors are resolved the same way as in the class queries.
+	wrapped := fmt.Errorf("handler: %w", critical)
+	assert.Equal(t, SeverityCritical, SeverityOf(wrapped))
+	multi = MultiError{detailed, wrapped}
+	assert.True(t, multi.Contains(clCritical))
+	assert.Equal(t, MultiError{wrapped}, multi.FilterSeverity(SeverityError))
+	assert.Equal(t, SeverityCritical, multi.MaxSeverity())
+}
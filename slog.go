@@ -0,0 +1,180 @@
+package errors
+
+import (
+	"context"
+	"log/slog"
+)
+
+// compile time check for the slog interfaces.
+var (
+	_ slog.LogValuer = &simpleError{}
+	_ slog.LogValuer = &detailedError{}
+	_ slog.LogValuer = MultiError{}
+	_ slog.Handler   = &SlogHandler{}
+)
+
+// LogValue implements slog.LogValuer interface.
+// The error is logged as a group described by the LogFields function.
+func (s *simpleError) LogValue() slog.Value {
+	return logValue(s)
+}
+
+// LogValue implements slog.LogValuer interface.
+// The error is logged as a group described by the LogFields function.
+func (e *detailedError) LogValue() slog.Value {
+	return logValue(e)
+}
+
+// LogValue implements slog.LogValuer interface.
+// The multi error is logged as a group described by the LogFields function.
+func (m MultiError) LogValue() slog.Value {
+	return logValue(m)
+}
+
+// SeverityLevel gets the slog.Level matching the 's' Severity.
+func SeverityLevel(s Severity) slog.Level {
+	switch s {
+	case SeverityDebug:
+		return slog.LevelDebug
+	case SeverityInfo:
+		return slog.LevelInfo
+	case SeverityWarning:
+		return slog.LevelWarn
+	case SeverityCritical:
+		return slog.LevelError + 4
+	default:
+		return slog.LevelError
+	}
+}
+
+// SlogHandler is the slog.Handler wrapper that expands the classified errors attributes,
+// including the ones wrapped by other errors, and sets the record level
+// from the most serious severity of the classified errors in the record
+// and the ones bound to the handler with the WithAttrs method.
+// The records of the levels disabled by the wrapped handler are dropped before their
+// attributes are inspected, unless the handler has bound errors which severity raises the level.
+type SlogHandler struct {
+	handler  slog.Handler
+	severity Severity
+}
+
+// NewSlogHandler creates new SlogHandler wrapping provided 'handler'.
+func NewSlogHandler(handler slog.Handler) *SlogHandler {
+	return &SlogHandler{handler: handler}
+}
+
+// Enabled implements slog.Handler interface.
+// If the handler has bound errors, the level is checked with their severity level.
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.severity != 0 {
+		return h.handler.Enabled(ctx, SeverityLevel(h.severity))
+	}
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler interface.
+func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	var attrs []slog.Attr
+	severity := h.severity
+	r.Attrs(func(attr slog.Attr) bool {
+		var s Severity
+		attr, s = expandErrorAttr(attr)
+		if s > severity {
+			severity = s
+		}
+		attrs = append(attrs, attr)
+		return true
+	})
+
+	level := r.Level
+	if severity != 0 {
+		level = SeverityLevel(severity)
+	}
+	if !h.handler.Enabled(ctx, level) {
+		return nil
+	}
+
+	record := slog.NewRecord(r.Time, level, r.Message, r.PC)
+	record.AddAttrs(attrs...)
+	return h.handler.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler interface.
+// The severity of the classified errors in the 'attrs' is bound to the returned handler.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	severity := h.severity
+	expanded := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		var s Severity
+		expanded[i], s = expandErrorAttr(attr)
+		if s > severity {
+			severity = s
+		}
+	}
+	return &SlogHandler{handler: h.handler.WithAttrs(expanded), severity: severity}
+}
+
+// WithGroup implements slog.Handler interface.
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	return &SlogHandler{handler: h.handler.WithGroup(name), severity: h.severity}
+}
+
+// expandErrorAttr expands the 'attr' if its value is a classified error.
+// Returns the severity of the error or zero value if the attribute is not a classified error.
+func expandErrorAttr(attr slog.Attr) (slog.Attr, Severity) {
+	switch attr.Value.Kind() {
+	case slog.KindAny, slog.KindLogValuer:
+	case slog.KindGroup:
+		var severity Severity
+		group := attr.Value.Group()
+		attrs := make([]slog.Attr, len(group))
+		for i, a := range group {
+			var s Severity
+			attrs[i], s = expandErrorAttr(a)
+			if s > severity {
+				severity = s
+			}
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(attrs...)}, severity
+	default:
+		return attr, 0
+	}
+	err, ok := attr.Value.Any().(error)
+	if !ok {
+		return attr, 0
+	}
+
+	var severity Severity
+	switch e := err.(type) {
+	case MultiError:
+		for _, err := range e {
+			if classError, ok := AsClassError(err); ok && SeverityOf(classError) > severity {
+				severity = SeverityOf(classError)
+			}
+		}
+	default:
+		classError, ok := AsClassError(err)
+		if !ok {
+			return attr, 0
+		}
+		severity = SeverityOf(classError)
+	}
+	return slog.Attr{Key: attr.Key, Value: logValue(err)}, severity
+}
+
+// logValue gets the slog group value of the 'err' LogFields.
+func logValue(err error) slog.Value {
+	return slog.GroupValue(slogAttrs(LogFields(err))...)
+}
+
+func slogAttrs(fields []LogField) []slog.Attr {
+	attrs := make([]slog.Attr, len(fields))
+	for i, field := range fields {
+		if group, ok := field.Value.([]LogField); ok {
+			attrs[i] = slog.Attr{Key: field.Key, Value: slog.GroupValue(slogAttrs(group)...)}
+			continue
+		}
+		attrs[i] = slog.Any(field.Key, field.Value)
+	}
+	return attrs
+}
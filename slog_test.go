@@ -0,0 +1,143 @@
+package errors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSlog tests the log/slog integration.
+func TestSlog(t *testing.T) {
+	resetContainer()
+
+	ts := time.Date(2019, 9, 1, 12, 30, 15, 0, time.UTC)
+	clock = func() time.Time { return ts }
+	defer func() { clock = time.Now }()
+
+	mjr := MustNewMajor()
+	clWarning := MustNewMinorClass(mjr, MustNewMinor(mjr))
+	clCritical := MustNewMinorClass(mjr, MustNewMinor(mjr))
+	SetClassName(clWarning, "warning")
+	SetClassSeverity(clWarning, SeverityWarning)
+	SetClassSeverity(clCritical, SeverityCritical)
+
+	assert.Equal(t, "warning", ClassName(clWarning))
+	assert.Equal(t, "", ClassName(clCritical))
+
+	// logRecord logs the message with the 'args' at error level and returns the decoded record.
+	// If 'wrap' is true the JSON handler is wrapped with the SlogHandler.
+	logRecord := func(t *testing.T, wrap bool, args ...interface{}) map[string]interface{} {
+		buf := &bytes.Buffer{}
+		var handler slog.Handler = slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo})
+		if wrap {
+			handler = NewSlogHandler(handler)
+		}
+		slog.New(handler).Error("failed", args...)
+		if buf.Len() == 0 {
+			return nil
+		}
+		record := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		return record
+	}
+
+	t.Run("LogValuer", func(t *testing.T) {
+		err := Build(clWarning).Msg("detailed").Detail("Details.").Field("model", "user").Cause(New(clCritical, "cause")).Err()
+
+		record := logRecord(t, false, "err", err)
+		assert.Equal(t, map[string]interface{}{
+			"message":    "detailed",
+			"class":      float64(clWarning),
+			"class_name": "warning",
+			"severity":   "warning",
+			"id":         err.ID().String(),
+			"timestamp":  "2019-09-01T12:30:15Z",
+			"details":    "Details.",
+			"operation":  err.Operation(),
+			"fields":     map[string]interface{}{"model": "user"},
+			"cause": map[string]interface{}{
+				"message":  "cause",
+				"class":    float64(clCritical),
+				"severity": "critical",
+			},
+		}, record["err"])
+		assert.Equal(t, "ERROR", record["level"])
+
+		record = logRecord(t, false, "err", MultiError{io.EOF, New(clWarning, "simple")})
+		assert.Equal(t, map[string]interface{}{
+			"count": float64(2),
+			"0":     "EOF",
+			"1": map[string]interface{}{
+				"message":    "simple",
+				"class":      float64(clWarning),
+				"class_name": "warning",
+				"severity":   "warning",
+			},
+		}, record["err"])
+	})
+
+	t.Run("Handler", func(t *testing.T) {
+		record := logRecord(t, true, "err", New(clWarning, "simple"))
+		assert.Equal(t, "WARN", record["level"])
+
+		wrapped := fmt.Errorf("wrapped: %w", New(clCritical, "critical"))
+		record = logRecord(t, true, "err", wrapped, "other", io.EOF)
+		assert.Equal(t, "ERROR+4", record["level"])
+		assert.Equal(t, map[string]interface{}{
+			"message":  "wrapped: critical",
+			"class":    float64(clCritical),
+			"severity": "critical",
+		}, record["err"])
+		assert.Equal(t, "EOF", record["other"])
+
+		record = logRecord(t, true, slog.Group("request", "err", MultiError{io.EOF, wrapped}))
+		assert.Equal(t, "ERROR+4", record["level"])
+		assert.Equal(t, "wrapped: critical", record["request"].(map[string]interface{})["err"].(map[string]interface{})["1"].(map[string]interface{})["message"])
+
+		SetClassSeverity(clWarning, SeverityDebug)
+		defer SetClassSeverity(clWarning, SeverityWarning)
+		assert.Nil(t, logRecord(t, true, "err", New(clWarning, "debug")))
+
+		record = logRecord(t, true, "err", io.EOF)
+		assert.Equal(t, "ERROR", record["level"])
+	})
+
+	t.Run("WithAttrs", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := slog.New(NewSlogHandler(slog.NewJSONHandler(buf, nil)))
+		logger.With("err", fmt.Errorf("wrapped: %w", New(clWarning, "simple"))).WithGroup("group").Info("message")
+
+		record := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		assert.Equal(t, "wrapped: simple", record["err"].(map[string]interface{})["message"])
+		assert.Equal(t, "WARN", record["level"])
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		handler := NewSlogHandler(slog.NewJSONHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		assert.False(t, handler.Enabled(context.Background(), slog.LevelDebug))
+		assert.True(t, handler.Enabled(context.Background(), slog.LevelInfo))
+
+		// the bound error severity raises the level of the records.
+		bound := handler.WithAttrs([]slog.Attr{slog.Any("err", New(clCritical, "critical"))})
+		assert.True(t, bound.Enabled(context.Background(), slog.LevelDebug))
+
+		buf := &bytes.Buffer{}
+		slog.New(NewSlogHandler(slog.NewJSONHandler(buf, nil))).With("err", New(clCritical, "critical")).Debug("message")
+		record := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		assert.Equal(t, "ERROR+4", record["level"])
+	})
+
+	assert.Equal(t, slog.LevelDebug, SeverityLevel(SeverityDebug))
+	assert.Equal(t, slog.LevelInfo, SeverityLevel(SeverityInfo))
+	assert.Equal(t, slog.LevelError, SeverityLevel(Severity(0)))
+}
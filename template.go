@@ -0,0 +1,87 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// compile time check for the Template interfaces.
+var _ ClassError = &Template{}
+
+// Template is the reusable detailed error definition bound to a Class.
+// It defines the message format, default details and fields of the errors
+// created with its New method. Each error created by the template matches it
+// while compared using 'errors.Is' function.
+type Template struct {
+	class   Class
+	format  string
+	details string
+	fields  map[string]interface{}
+}
+
+// NewTemplate creates new error Template for provided 'c' Class and message 'format'.
+func NewTemplate(c Class, format string) *Template {
+	return &Template{class: c, format: format}
+}
+
+// Class implements ClassError interface.
+func (t *Template) Class() Class {
+	return t.class
+}
+
+// Error implements error interface. Returns the template message format.
+func (t *Template) Error() string {
+	return t.format
+}
+
+// Details gets the template default details.
+func (t *Template) Details() string {
+	return t.details
+}
+
+// Fields gets the copy of the template default fields.
+func (t *Template) Fields() map[string]interface{} {
+	if t.fields == nil {
+		return nil
+	}
+	fields := make(map[string]interface{}, len(t.fields))
+	for k, v := range t.fields {
+		fields[k] = v
+	}
+	return fields
+}
+
+// WithDetails sets the default 'details' for all errors created by the template and returns itself.
+func (t *Template) WithDetails(details string) *Template {
+	t.details = details
+	return t
+}
+
+// WithField sets the default field with provided 'key' and 'value' for all errors
+// created by the template and returns itself.
+func (t *Template) WithField(key string, value interface{}) *Template {
+	if t.fields == nil {
+		t.fields = map[string]interface{}{}
+	}
+	t.fields[key] = value
+	return t
+}
+
+// New creates new DetailedError with the template class, the message formatted
+// with provided 'args' and the template default details and fields.
+func (t *Template) New(args ...interface{}) DetailedError {
+	err := newDetailed(t.class)
+	err.template = t
+	if len(args) == 0 {
+		err.message = t.format
+	} else {
+		err.message = fmt.Sprintf(t.format, args...)
+	}
+	if t.details != "" {
+		err.details = []Detail{{Text: t.details, Operation: err.operation}}
+	}
+	for k, v := range t.fields {
+		err.SetField(k, v)
+	}
+	notifyCreated(err)
+	return err
+}
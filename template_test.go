@@ -0,0 +1,55 @@
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTemplate tests the error templates.
+func TestTemplate(t *testing.T) {
+	resetContainer()
+
+	tmpl := NewTemplate(ClInvalidIndex, "model: '%s' not found").
+		WithDetails("The model is not registered.").
+		WithField("repository", "main")
+
+	assert.Equal(t, ClInvalidIndex, tmpl.Class())
+	assert.Equal(t, "model: '%s' not found", tmpl.Error())
+
+	first := tmpl.New("user").(*detailedError)
+	second := tmpl.New("car").(*detailedError)
+
+	assert.Equal(t, ClInvalidIndex, first.Class())
+	assert.Equal(t, "model: 'user' not found", first.Error())
+	assert.Equal(t, "model: 'car' not found", second.Error())
+	assert.Equal(t, "The model is not registered.", first.Details())
+	assert.Equal(t, "main", first.Fields()["repository"])
+	assert.NotEqual(t, first.ID(), second.ID())
+	assert.Equal(t, "github.com/neuronlabs/errors.TestTemplate#template_test.go:22", first.Operation())
+
+	// the fields should not be shared between the instances.
+	first.SetField("model", "user")
+	_, ok := second.Fields()["model"]
+	assert.False(t, ok)
+	_, ok = tmpl.Fields()["model"]
+	assert.False(t, ok)
+
+	// the returned template fields should be a copy.
+	tmpl.Fields()["model"] = "changed"
+	_, ok = tmpl.New("bike").(*detailedError).Fields()["model"]
+	assert.False(t, ok)
+
+	assert.True(t, stderrors.Is(first, tmpl))
+	assert.True(t, stderrors.Is(second, tmpl))
+
+	other := NewTemplate(ClInvalidIndex, "model: '%s' not found")
+	assert.False(t, stderrors.Is(first, other))
+	assert.False(t, stderrors.Is(NewDet(ClInvalidIndex, "model: 'user' not found"), tmpl))
+
+	noArgs := NewTemplate(ClInvalidMajor, "100% invalid").New()
+	require.NotNil(t, noArgs)
+	assert.Equal(t, "100% invalid", noArgs.Error())
+}
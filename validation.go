@@ -0,0 +1,140 @@
+package errors
+
+import (
+	"strings"
+)
+
+// compile time check for the validation errors interfaces.
+var (
+	_ ClassError = &FieldError{}
+	_ error      = &ValidationErrors{}
+)
+
+// FieldError is the ClassError bound to the input location.
+// The Path might be a JSON pointer i.e. '/user/emails/0' or a dot separated
+// struct path i.e. 'User.Emails[0]'.
+type FieldError struct {
+	ClassError
+	Path string
+}
+
+// NewFieldError creates new FieldError for provided 'path' and 'err'.
+func NewFieldError(path string, err ClassError) *FieldError {
+	return &FieldError{ClassError: err, Path: path}
+}
+
+// Error implements error interface.
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.ClassError.Error()
+	}
+	return e.Path + ": " + e.ClassError.Error()
+}
+
+// Unwrap gets the underlying ClassError.
+func (e *FieldError) Unwrap() error {
+	return e.ClassError
+}
+
+// ValidationErrors is the MultiError of the FieldErrors.
+type ValidationErrors struct {
+	MultiError
+}
+
+// Add adds the 'err' bound to the 'path' input location. A nil 'err' is not added.
+func (v *ValidationErrors) Add(path string, err ClassError) {
+	if err == nil {
+		return
+	}
+	v.MultiError = append(v.MultiError, NewFieldError(path, err))
+}
+
+// Merge adds the 'nested' validation errors with their paths prefixed with the 'prefix'.
+// Used for the nested structures validation. A nil 'nested' is not merged.
+func (v *ValidationErrors) Merge(prefix string, nested *ValidationErrors) {
+	if nested == nil {
+		return
+	}
+	for _, fieldError := range nested.FieldErrors() {
+		v.Add(JoinPath(prefix, fieldError.Path), fieldError.ClassError)
+	}
+}
+
+// FieldErrors gets all the field errors.
+func (v *ValidationErrors) FieldErrors() []*FieldError {
+	fieldErrors := make([]*FieldError, 0, len(v.MultiError))
+	for _, err := range v.MultiError {
+		if fieldError, ok := err.(*FieldError); ok {
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+	return fieldErrors
+}
+
+// ByPath gets the field errors of the 'path' and all the paths nested in it.
+func (v *ValidationErrors) ByPath(path string) []*FieldError {
+	var fieldErrors []*FieldError
+	for _, fieldError := range v.FieldErrors() {
+		if isSubPath(path, fieldError.Path) {
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+	return fieldErrors
+}
+
+// HasPath checks if there is any error for the 'path' or any of the paths nested in it.
+func (v *ValidationErrors) HasPath(path string) bool {
+	return len(v.ByPath(path)) > 0
+}
+
+// Map gets the error messages mapped by their paths.
+// Used for rendering the validation errors in the API responses.
+func (v *ValidationErrors) Map() map[string][]string {
+	messages := map[string][]string{}
+	for _, fieldError := range v.FieldErrors() {
+		messages[fieldError.Path] = append(messages[fieldError.Path], fieldError.ClassError.Error())
+	}
+	return messages
+}
+
+// ErrorOrNil returns nil if there are no validation errors or the validation errors itself otherwise.
+func (v *ValidationErrors) ErrorOrNil() error {
+	if len(v.MultiError) == 0 {
+		return nil
+	}
+	return v
+}
+
+// JoinPath joins the 'prefix' and the 'path'. If the prefix is a JSON pointer
+// the paths are joined with a slash i.e. '/user' + 'name' = '/user/name'.
+// Otherwise the paths are joined with a dot i.e. 'User' + 'Name' = 'User.Name'
+// unless the path is an index i.e. 'Emails' + '[0]' = 'Emails[0]'.
+func JoinPath(prefix, path string) string {
+	switch {
+	case prefix == "":
+		return path
+	case path == "":
+		return prefix
+	case strings.HasPrefix(prefix, "/"):
+		return strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+	case strings.HasPrefix(path, "["):
+		return prefix + path
+	default:
+		return prefix + "." + path
+	}
+}
+
+// isSubPath checks if the 'path' is equal to the 'prefix' or is nested in it.
+func isSubPath(prefix, path string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	if len(path) == len(prefix) || prefix == "" {
+		return true
+	}
+	switch path[len(prefix)] {
+	case '/', '.', '[':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidationErrors tests the field validation errors aggregation.
+func TestValidationErrors(t *testing.T) {
+	resetContainer()
+
+	mjr := MustNewMajor()
+	clRequired := MustNewMinorClass(mjr, MustNewMinor(mjr))
+	clInvalid := MustNewMinorClass(mjr, MustNewMinor(mjr))
+
+	t.Run("Paths", func(t *testing.T) {
+		assert.Equal(t, "User.Name", JoinPath("User", "Name"))
+		assert.Equal(t, "User.Emails[0]", JoinPath("User.Emails", "[0]"))
+		assert.Equal(t, "/user/name", JoinPath("/user", "name"))
+		assert.Equal(t, "/user/name", JoinPath("/user/", "/name"))
+		assert.Equal(t, "name", JoinPath("", "name"))
+		assert.Equal(t, "user", JoinPath("user", ""))
+	})
+
+	t.Run("Aggregate", func(t *testing.T) {
+		address := &ValidationErrors{}
+		address.Add("Street", New(clRequired, "street is required"))
+		address.Add("Zip", Newf(clInvalid, "invalid zip code: '%s'", "00"))
+
+		v := &ValidationErrors{}
+		assert.NoError(t, v.ErrorOrNil())
+
+		v.Add("Name", New(clRequired, "name is required"))
+		v.Add("Emails[1]", New(clInvalid, "invalid email"))
+		v.Add("Name", New(clInvalid, "name too short"))
+		v.Merge("Addresses[0]", address)
+
+		err := v.ErrorOrNil()
+		require.Error(t, err)
+		assert.Equal(t, "Name: name is required, Emails[1]: invalid email, Name: name too short, Addresses[0].Street: street is required, Addresses[0].Zip: invalid zip code: '00'", err.Error())
+
+		assert.Len(t, v.FieldErrors(), 5)
+		assert.Len(t, v.ByPath("Name"), 2)
+		assert.Len(t, v.ByPath("Addresses"), 2)
+		assert.Len(t, v.ByPath("Addresses[0].Zip"), 1)
+		assert.True(t, v.HasPath("Emails"))
+		assert.False(t, v.HasPath("Emails[0]"))
+		assert.False(t, v.HasPath("Nam"))
+
+		assert.Equal(t, map[string][]string{
+			"Name":                {"name is required", "name too short"},
+			"Emails[1]":           {"invalid email"},
+			"Addresses[0].Street": {"street is required"},
+			"Addresses[0].Zip":    {"invalid zip code: '00'"},
+		}, v.Map())
+
+		assert.True(t, v.Contains(clRequired))
+		assert.Equal(t, []Class{clRequired, clInvalid}, v.Classes())
+
+		var fieldError *FieldError
+		require.True(t, stderrors.As(err, &fieldError))
+		assert.Equal(t, "Name", fieldError.Path)
+		assert.Equal(t, clRequired, fieldError.Class())
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		v := &ValidationErrors{}
+		v.Add("Name", nil)
+		v.Merge("Address", nil)
+		assert.NoError(t, v.ErrorOrNil())
+		assert.Empty(t, v.FieldErrors())
+	})
+}